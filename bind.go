@@ -7,8 +7,10 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/column"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 )
@@ -50,16 +52,107 @@ func DateNamed(name string, value time.Time, scale TimeUnit) driver.NamedDateVal
 var (
 	bindNumericRe    = regexp.MustCompile(`\$[0-9]+`)
 	bindPositionalRe = regexp.MustCompile(`[^\\][?]`)
+	bindNamedRe      = regexp.MustCompile(`@[a-zA-Z0-9\_]+`)
 )
 
 func bind(tz *time.Location, query string, args ...any) (string, error) {
 	if len(args) == 0 {
 		return query, nil
 	}
+	return newBindTemplate(query).bind(tz, args...)
+}
+
+// bindTemplate is a query split at its placeholders, so that arguments can be bound to it
+// repeatedly without parsing the query again, e.g. by a prepared statement. The query is only
+// split at the kind of placeholders the arguments are bound to, on first use.
+type bindTemplate struct {
+	query          string
+	haveNumeric    bool
+	havePositional bool
+	positional     []string // the text around ? placeholders with \? unescaped, nil without placeholders
+	numeric        []bindPart
+	named          []bindPart
+	positionalOnce sync.Once
+	numericOnce    sync.Once
+	namedOnce      sync.Once
+}
+
+// bindPart is the text in front of a placeholder such as $1 or @name, the last part has no placeholder.
+type bindPart struct {
+	text        string
+	placeholder string
+}
+
+func newBindTemplate(query string) *bindTemplate {
+	return &bindTemplate{
+		query:          query,
+		haveNumeric:    bindNumericRe.MatchString(query),
+		havePositional: bindPositionalRe.MatchString(query),
+	}
+}
+
+func (t *bindTemplate) positionalParts() []string {
+	t.positionalOnce.Do(func() {
+		t.positional = splitPositional(t.query)
+	})
+	return t.positional
+}
+
+func (t *bindTemplate) numericParts() []bindPart {
+	t.numericOnce.Do(func() {
+		t.numeric = splitPlaceholders(t.query, bindNumericRe)
+	})
+	return t.numeric
+}
+
+func (t *bindTemplate) namedParts() []bindPart {
+	t.namedOnce.Do(func() {
+		t.named = splitPlaceholders(t.query, bindNamedRe)
+	})
+	return t.named
+}
+
+func splitPositional(query string) []string {
 	var (
-		haveNumeric    bool
-		havePositional bool
+		parts []string
+		buf   = make([]byte, 0, len(query))
+		found bool
 	)
+	for i := 0; i < len(query); i++ {
+		// It's fine looping through the query string as bytes, because the (fixed) characters we're looking for
+		// are in the ASCII range to won't take up more than one byte.
+		switch {
+		case query[i] != '?':
+			buf = append(buf, query[i])
+		case i > 0 && query[i-1] == '\\':
+			buf[len(buf)-1], found = '?', true
+		default:
+			parts, buf, found = append(parts, string(buf)), buf[:0], true
+		}
+	}
+	if !found {
+		return nil
+	}
+	return append(parts, string(buf))
+}
+
+func splitPlaceholders(query string, re *regexp.Regexp) []bindPart {
+	var (
+		parts []bindPart
+		last  int
+	)
+	for _, match := range re.FindAllStringIndex(query, -1) {
+		parts = append(parts, bindPart{text: query[last:match[0]], placeholder: query[match[0]:match[1]]})
+		last = match[1]
+	}
+	return append(parts, bindPart{text: query[last:]})
+}
+
+// bind returns the query with the placeholders replaced by args, which are either all named or all anonymous.
+func (t *bindTemplate) bind(tz *time.Location, args ...any) (string, error) {
+	if len(args) == 0 {
+		return t.query, nil
+	}
 
 	allArgumentsNamed, err := checkAllNamedArguments(args...)
 	if err != nil {
@@ -67,18 +160,15 @@ func bind(tz *time.Location, query string, args ...any) (string, error) {
 	}
 
 	if allArgumentsNamed {
-		return bindNamed(tz, query, args...)
+		return t.bindNamed(tz, args...)
 	}
-
-	haveNumeric = bindNumericRe.MatchString(query)
-	havePositional = bindPositionalRe.MatchString(query)
-	if haveNumeric && havePositional {
+	if t.haveNumeric && t.havePositional {
 		return "", ErrBindMixedParamsFormats
 	}
-	if haveNumeric {
-		return bindNumeric(tz, query, args...)
+	if t.haveNumeric {
+		return t.bindNumeric(tz, args...)
 	}
-	return bindPositional(tz, query, args...)
+	return t.bindPositional(tz, args...)
 }
 
 func checkAllNamedArguments(args ...any) (bool, error) {
@@ -100,75 +190,43 @@ func checkAllNamedArguments(args ...any) (bool, error) {
 	return haveNamed, nil
 }
 
-func bindPositional(tz *time.Location, query string, args ...any) (_ string, err error) {
-	var (
-		lastMatchIndex = -1 // Position of previous match for copying
-		argIndex       = 0  // Index for the argument at current position
-		buf            = make([]byte, 0, len(query))
-		unbindCount    = 0 // Number of positional arguments that couldn't be matched
-	)
-
-	for i := 0; i < len(query); i++ {
-		// It's fine looping through the query string as bytes, because the (fixed) characters we're looking for
-		// are in the ASCII range to won't take up more than one byte.
-		if query[i] == '?' {
-			if i > 0 && query[i-1] == '\\' {
-				// Copy all previous index to here characters
-				buf = append(buf, query[lastMatchIndex+1:i-1]...)
-				buf = append(buf, '?')
-			} else {
-				// Copy all previous index to here characters
-				buf = append(buf, query[lastMatchIndex+1:i]...)
-
-				// Append the argument value
-				if argIndex < len(args) {
-					v := args[argIndex]
-					if fn, ok := v.(std_driver.Valuer); ok {
-						if v, err = fn.Value(); err != nil {
-							return "", nil
-						}
-					}
-
-					value, err := format(tz, Seconds, v)
-					if err != nil {
-						return "", err
-					}
-
-					buf = append(buf, value...)
-					argIndex++
-				} else {
-					unbindCount++
-				}
-			}
-
-			lastMatchIndex = i
-		}
+func (t *bindTemplate) bindPositional(tz *time.Location, args ...any) (_ string, err error) {
+	// If there are no placeholders, quick return without copying the string
+	parts := t.positionalParts()
+	if parts == nil {
+		return t.query, nil
 	}
-
-	// If there were no replacements, quick return without copying the string
-	if lastMatchIndex < 0 {
-		return query, nil
-	}
-
-	// Append the remainder
-	buf = append(buf, query[lastMatchIndex+1:]...)
-
-	if unbindCount > 0 {
+	if unbindCount := len(parts) - 1 - len(args); unbindCount > 0 {
 		return "", fmt.Errorf("have no arg for param ? at last %d positions", unbindCount)
 	}
 
+	buf := make([]byte, 0, len(t.query))
+	for i, part := range parts {
+		buf = append(buf, part...)
+		if i == len(parts)-1 {
+			break
+		}
+		v := args[i]
+		if fn, ok := v.(std_driver.Valuer); ok {
+			if v, err = fn.Value(); err != nil {
+				return "", err
+			}
+		}
+		value, err := format(tz, Seconds, v)
+		if err != nil {
+			return "", err
+		}
+		buf = append(buf, value...)
+	}
 	return string(buf), nil
 }
 
-func bindNumeric(tz *time.Location, query string, args ...any) (_ string, err error) {
-	var (
-		unbind = make(map[string]struct{})
-		params = make(map[string]string)
-	)
+func (t *bindTemplate) bindNumeric(tz *time.Location, args ...any) (_ string, err error) {
+	params := make(map[string]string, len(args))
 	for i, v := range args {
 		if fn, ok := v.(std_driver.Valuer); ok {
 			if v, err = fn.Value(); err != nil {
-				return "", nil
+				return "", err
 			}
 		}
 		val, err := format(tz, Seconds, v)
@@ -177,26 +235,13 @@ func bindNumeric(tz *time.Location, query string, args ...any) (_ string, err er
 		}
 		params[fmt.Sprintf("$%d", i+1)] = val
 	}
-	query = bindNumericRe.ReplaceAllStringFunc(query, func(n string) string {
-		if _, found := params[n]; !found {
-			unbind[n] = struct{}{}
-			return ""
-		}
-		return params[n]
+	return t.replace(t.numericParts(), params, func(placeholder string) error {
+		return fmt.Errorf("have no arg for %s param", placeholder)
 	})
-	for param := range unbind {
-		return "", fmt.Errorf("have no arg for %s param", param)
-	}
-	return query, nil
 }
 
-var bindNamedRe = regexp.MustCompile(`@[a-zA-Z0-9\_]+`)
-
-func bindNamed(tz *time.Location, query string, args ...any) (_ string, err error) {
-	var (
-		unbind = make(map[string]struct{})
-		params = make(map[string]string)
-	)
+func (t *bindTemplate) bindNamed(tz *time.Location, args ...any) (_ string, err error) {
+	params := make(map[string]string, len(args))
 	for _, v := range args {
 		switch v := v.(type) {
 		case driver.NamedValue:
@@ -219,17 +264,29 @@ func bindNamed(tz *time.Location, query string, args ...any) (_ string, err erro
 			params["@"+v.Name] = val
 		}
 	}
-	query = bindNamedRe.ReplaceAllStringFunc(query, func(n string) string {
-		if _, found := params[n]; !found {
-			unbind[n] = struct{}{}
-			return ""
-		}
-		return params[n]
+	return t.replace(t.namedParts(), params, func(placeholder string) error {
+		return fmt.Errorf("have no arg for %q param", placeholder)
 	})
-	for param := range unbind {
-		return "", fmt.Errorf("have no arg for %q param", param)
+}
+
+// replace joins parts with their placeholders replaced by params.
+func (t *bindTemplate) replace(parts []bindPart, params map[string]string, unbound func(placeholder string) error) (string, error) {
+	if len(parts) == 1 {
+		return t.query, nil
 	}
-	return query, nil
+	buf := make([]byte, 0, len(t.query))
+	for _, part := range parts {
+		buf = append(buf, part.text...)
+		if part.placeholder == "" {
+			continue
+		}
+		val, found := params[part.placeholder]
+		if !found {
+			return "", unbound(part.placeholder)
+		}
+		buf = append(buf, val...)
+	}
+	return string(buf), nil
 }
 
 func formatTime(tz *time.Location, scale TimeUnit, value time.Time) (string, error) {
@@ -313,6 +370,13 @@ func format(tz *time.Location, scale TimeUnit, v any) (string, error) {
 			return "", err
 		}
 		return fmt.Sprintf("[%s]", val), nil
+	case chcol.Interval:
+		return formatInterval(v)
+	case *chcol.Interval:
+		if v == nil {
+			return "NULL", nil
+		}
+		return formatInterval(*v)
 	case fmt.Stringer:
 		if v := reflect.ValueOf(v); v.Kind() == reflect.Pointer &&
 			v.IsNil() &&
@@ -390,6 +454,13 @@ func format(tz *time.Location, scale TimeUnit, v any) (string, error) {
 	return fmt.Sprint(v), nil
 }
 
+func formatInterval(v chcol.Interval) (string, error) {
+	if !v.Unit.Valid() {
+		return "", fmt.Errorf("invalid interval unit %q", v.Unit)
+	}
+	return fmt.Sprintf("INTERVAL %d %s", v.Value, strings.ToUpper(string(v.Unit))), nil
+}
+
 func join[E any](tz *time.Location, scale TimeUnit, values []E) (string, error) {
 	items := make([]string, len(values), len(values))
 	for i := range values {
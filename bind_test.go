@@ -330,6 +330,20 @@ func TestFormatMap(t *testing.T) {
 	assert.Equal(t, "map('a', 1)", val)
 }
 
+func TestFormatInterval(t *testing.T) {
+	val, err := format(time.UTC, Seconds, Interval{Value: 5, Unit: IntervalMinute})
+	require.NoError(t, err)
+	assert.Equal(t, "INTERVAL 5 MINUTE", val)
+	val, err = format(time.UTC, Seconds, []Interval{{Value: -1, Unit: IntervalDay}, {Value: 2, Unit: IntervalQuarter}})
+	require.NoError(t, err)
+	assert.Equal(t, "[INTERVAL -1 DAY, INTERVAL 2 QUARTER]", val)
+	val, err = format(time.UTC, Seconds, (*Interval)(nil))
+	require.NoError(t, err)
+	assert.Equal(t, "NULL", val)
+	_, err = format(time.UTC, Seconds, Interval{Value: 1, Unit: "Fortnight"})
+	assert.Error(t, err)
+}
+
 func TestTimezoneSQLEscaping(t *testing.T) {
 	t.Run("prevent SQL injection via timezone name", func(t *testing.T) {
 		maliciousLoc := time.FixedZone("UTC') UNION ALL SELECT 1,2,3 --", 0)
@@ -441,6 +455,43 @@ func TestBindNamedWithTernaryOperator(t *testing.T) {
 	}
 }
 
+func TestBindTemplate(t *testing.T) {
+	template := newBindTemplate("SELECT ? + ?, '\\?'")
+	for _, args := range [][]any{{1, 2}, {"a", "b"}} {
+		expected, err := bind(time.UTC, template.query, args...)
+		require.NoError(t, err)
+		actual, err := template.bind(time.UTC, args...)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	}
+	actual, err := template.bind(time.UTC, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT 1 + 2, '?'", actual)
+	_, err = template.bind(time.UTC, 1)
+	assert.EqualError(t, err, "have no arg for param ? at last 1 positions")
+
+	template = newBindTemplate("SELECT $2, $1, @name")
+	actual, err = template.bind(time.UTC, "a", "b")
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT 'b', 'a', @name", actual)
+	actual, err = template.bind(time.UTC, Named("name", 3))
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT $2, $1, 3", actual)
+	_, err = template.bind(time.UTC, "a")
+	assert.EqualError(t, err, "have no arg for $2 param")
+
+	_, err = newBindTemplate("SELECT ?, $1").bind(time.UTC, 1)
+	assert.ErrorIs(t, err, ErrBindMixedParamsFormats)
+
+	// only the placeholders the arguments are bound to are split
+	template = newBindTemplate("SELECT ?, @name")
+	_, err = template.bind(time.UTC, Named("name", 1))
+	require.NoError(t, err)
+	assert.NotNil(t, template.named)
+	assert.Nil(t, template.positional)
+	assert.Nil(t, template.numeric)
+}
+
 func BenchmarkBindNumeric(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
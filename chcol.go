@@ -1,6 +1,11 @@
 package clickhouse
 
-import "github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
+import (
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/column"
+)
 
 // Re-export chcol types/funcs to top level clickhouse package
 
@@ -9,6 +14,8 @@ type (
 	Variant = chcol.Variant
 	// Dynamic is an alias for the Variant type
 	Dynamic = chcol.Dynamic
+	// VariantCases maps ClickHouse type names to the handlers called by Variant.Switch
+	VariantCases = chcol.VariantCases
 	// JSON represents a ClickHouse JSON type that can hold multiple possible types
 	JSON = chcol.JSON
 
@@ -19,8 +26,55 @@ type (
 	// JSONDeserializer interface allows a struct to load its data from an optimized JSON structure instead of relying
 	// on recursive reflection to set its fields.
 	JSONDeserializer = chcol.JSONDeserializer
+
+	// Interval represents a value of a ClickHouse Interval type, e.g. toIntervalMinute(5)
+	Interval = chcol.Interval
+	// IntervalUnit is the unit of a ClickHouse Interval type
+	IntervalUnit = chcol.IntervalUnit
+
+	// TimeOfDay is a civil time of day for Time and Time64 values that are clock times rather than durations
+	TimeOfDay = chcol.TimeOfDay
+
+	// Decimal64 is a fixed-point decimal stored as an unscaled int64 and its scale
+	Decimal64 = chcol.Decimal64
+	// Decimal128 is a fixed-point decimal stored as an unscaled 128-bit integer and its scale
+	Decimal128 = chcol.Decimal128
+)
+
+const (
+	IntervalNanosecond  = chcol.IntervalNanosecond
+	IntervalMicrosecond = chcol.IntervalMicrosecond
+	IntervalMillisecond = chcol.IntervalMillisecond
+	IntervalSecond      = chcol.IntervalSecond
+	IntervalMinute      = chcol.IntervalMinute
+	IntervalHour        = chcol.IntervalHour
+	IntervalDay         = chcol.IntervalDay
+	IntervalWeek        = chcol.IntervalWeek
+	IntervalMonth       = chcol.IntervalMonth
+	IntervalQuarter     = chcol.IntervalQuarter
+	IntervalYear        = chcol.IntervalYear
 )
 
+// NewInterval converts d into an Interval of the given sub-day unit
+func NewInterval(d time.Duration, unit IntervalUnit) (Interval, error) {
+	return chcol.NewInterval(d, unit)
+}
+
+// NewTimeOfDay returns the time of day from the given clock fields
+func NewTimeOfDay(hour, minute, second, nanosecond int) (TimeOfDay, error) {
+	return chcol.NewTimeOfDay(hour, minute, second, nanosecond)
+}
+
+// TimeOfDayOf returns the clock time of t in its location
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	return chcol.TimeOfDayOf(t)
+}
+
+// ParseTimeOfDay parses a time of day such as "09:30:00" or "17:45:30.250"
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	return chcol.ParseTimeOfDay(s)
+}
+
 // NewVariant creates a new Variant with the given value
 func NewVariant(v any) Variant {
 	return chcol.NewVariant(v)
@@ -58,3 +112,23 @@ func ExtractJSONPathAs[T any](o *JSON, path string) (valueAs T, ok bool) {
 func ExtractJSONPathAsDynamic(o *JSON, path string) (Dynamic, bool) {
 	return chcol.ExtractJSONPathAsDynamic(o, path)
 }
+
+// JSONTypeOf returns the JSON column type declared by the `chType` tags of struct v, e.g. JSON(a.b UInt32, SKIP c).
+func JSONTypeOf(v any) (column.Type, error) {
+	return column.JSONTypeOf(v)
+}
+
+// StrictJSON wraps a pointer to a struct so scanning a JSON column into it fails on unmapped dynamic paths.
+func StrictJSON(dest any) any {
+	return column.StrictJSON(dest)
+}
+
+// ScanVariant scans the value of a Variant or Dynamic into dest with the ScanRow rules of the column of its ClickHouse type.
+func ScanVariant(v Variant, dest any) error {
+	return column.ScanVariant(v, dest)
+}
+
+// VariantAs returns the value of a Variant or Dynamic converted to T with the ScanRow rules of the column of its ClickHouse type.
+func VariantAs[T any](v Variant) (T, error) {
+	return column.VariantAs[T](v)
+}
@@ -25,6 +25,17 @@ type (
 	Exception     = proto.Exception
 	ProfileInfo   = proto.ProfileInfo
 	ServerVersion = proto.ServerHandshake
+
+	// ColumnMarshaler allows a user defined type to be appended to any column by converting itself
+	// into a Go value supported by the column.
+	ColumnMarshaler = column.ColumnMarshaler
+	// ColumnUnmarshaler allows a user defined type to be scanned from any column by converting
+	// from the column's base Go value.
+	ColumnUnmarshaler = column.ColumnUnmarshaler
+	// LowCardinalityKey is the position of a LowCardinality value in the dictionary of its block.
+	LowCardinalityKey = column.LowCardinalityKey
+	// EnumType is implemented by Go types bound to Enum8 and Enum16 columns, see cmd/enumgen.
+	EnumType = column.EnumType
 )
 
 var (
@@ -37,6 +48,11 @@ var (
 	ErrAcquireConnNoAddress      = errors.New("clickhouse: no valid address supplied")
 	ErrServerUnexpectedData      = errors.New("code: 101, message: Unexpected packet Data received from client")
 	ErrConnectionClosed          = errors.New("clickhouse: connection is closed")
+	ErrUnsupportedIsolationLevel = errors.New("clickhouse: unsupported transaction isolation level")
+	ErrReadOnlyTransaction       = errors.New("clickhouse: read-only transactions are not supported")
+	ErrTransactionSession        = errors.New("clickhouse: transactions over HTTP require HttpSessions or a session_id setting")
+	ErrRollbackUnsupported       = errors.New("clickhouse: statements executed in the transaction were not rolled back, enable Options.ExperimentalTransactions")
+	ErrRawFormatUnsupported      = errors.New("clickhouse: raw query results are only supported by the HTTP protocol")
 )
 
 type OpError struct {
@@ -114,6 +130,17 @@ type clickhouse struct {
 	closed    *atomic.Bool
 }
 
+var _ driver.Conn = (*clickhouse)(nil)
+var _ driver.ScriptExecer = (*clickhouse)(nil)
+var _ driver.ResultExecer = (*clickhouse)(nil)
+var _ driver.RawQueryer = (*clickhouse)(nil)
+var _ driver.RawInserter = (*clickhouse)(nil)
+
+// errUnsupportedConn is returned by the functions taking a driver.Conn that lacks one of the optional interfaces.
+func errUnsupportedConn(conn driver.Conn, iface string) error {
+	return fmt.Errorf("clickhouse: %T does not implement %s: %w", conn, iface, errors.ErrUnsupported)
+}
+
 func (clickhouse) Contributors() []string {
 	list := contributors.List
 	if len(list[len(list)-1]) == 0 {
@@ -178,6 +205,19 @@ func (ch *clickhouse) Exec(ctx context.Context, query string, args ...any) error
 	return nil
 }
 
+// ExecWithResult implements driver.ResultExecer, see the ExecWithResult function.
+func (ch *clickhouse) ExecWithResult(ctx context.Context, query string, args ...any) (driver.ExecResult, error) {
+	conn, err := ch.acquire(ctx)
+	if err != nil {
+		return driver.ExecResult{}, err
+	}
+	conn.getLogger().Debug("executing statement with result", slog.String("sql", query))
+
+	result, err := execWithResult(ctx, conn, query, args...)
+	ch.release(conn, err)
+	return result, err
+}
+
 func (ch *clickhouse) PrepareBatch(ctx context.Context, query string, opts ...driver.PrepareBatchOption) (driver.Batch, error) {
 	conn, err := ch.acquire(ctx)
 	if err != nil {
@@ -114,6 +114,8 @@ type DialResult struct {
 type HTTPProxy func(*http.Request) (*url.URL, error)
 
 type Options struct {
+	// Protocol is Native, the default, or HTTP. Some features differ over HTTP, e.g. the progress
+	// reported to WithProgress callbacks is received at once when the response starts, not while the query runs.
 	Protocol   Protocol
 	ClientInfo ClientInfo
 
@@ -179,6 +181,29 @@ type Options struct {
 	// Set a custom transport for the http client.
 	// The default transport configured by the library is passed in as an argument.
 	TransportFunc func(*http.Transport) (http.RoundTripper, error)
+
+	// ZeroCopyStrings makes String and FixedString columns scan into *[]byte and *string
+	// as views of the block buffer instead of copies. Scanned values are only valid until
+	// the next block is read by Rows.Next. Can be enabled per query with WithZeroCopyStrings.
+	ZeroCopyStrings bool
+
+	// ExperimentalTransactions makes database/sql transactions server transactions: BeginTx runs
+	// BEGIN TRANSACTION and Commit and Rollback run COMMIT and ROLLBACK, so that every statement of the
+	// transaction is rolled back, not only INSERT batches. The server must have allow_experimental_transactions
+	// enabled and the tables must be MergeTree tables. Over HTTP, HttpSessions or a session_id setting is required.
+	ExperimentalTransactions bool
+
+	// HttpSessions gives every HTTP connection its own server session, so that temporary tables and the changes
+	// made by SET and USE are kept between the queries executed on the same connection. The queries of a
+	// database/sql *sql.Conn or transaction always use the same connection, other queries may use any
+	// connection of the pool.
+	HttpSessions bool
+	// HttpSessionTimeout is the session_timeout of HTTP sessions, the server default if zero.
+	// It is rounded up to seconds.
+	HttpSessionTimeout time.Duration
+	// HttpSessionCheck makes queries fail when the HTTP session of their connection has expired,
+	// instead of running in a new empty session. The connection is then discarded.
+	HttpSessionCheck bool
 }
 
 func (o *Options) fromDSN(in string) error {
@@ -292,6 +317,41 @@ func (o *Options) fromDSN(in string) error {
 					return fmt.Errorf("clickhouse [dsn parse]:verify: %s", err)
 				}
 			}
+		case "experimental_transactions":
+			transactionsParam := params.Get(v)
+			if transactionsParam == "" {
+				o.ExperimentalTransactions = true
+			} else {
+				o.ExperimentalTransactions, err = strconv.ParseBool(transactionsParam)
+				if err != nil {
+					return fmt.Errorf("clickhouse [dsn parse]:experimental_transactions: %s", err)
+				}
+			}
+		case "http_sessions":
+			sessionsParam := params.Get(v)
+			if sessionsParam == "" {
+				o.HttpSessions = true
+			} else {
+				o.HttpSessions, err = strconv.ParseBool(sessionsParam)
+				if err != nil {
+					return fmt.Errorf("clickhouse [dsn parse]:http_sessions: %s", err)
+				}
+			}
+		case "http_session_timeout":
+			o.HttpSessionTimeout, err = time.ParseDuration(params.Get(v))
+			if err != nil {
+				return fmt.Errorf("clickhouse [dsn parse]:http_session_timeout: %s", err)
+			}
+		case "http_session_check":
+			sessionCheckParam := params.Get(v)
+			if sessionCheckParam == "" {
+				o.HttpSessionCheck = true
+			} else {
+				o.HttpSessionCheck, err = strconv.ParseBool(sessionCheckParam)
+				if err != nil {
+					return fmt.Errorf("clickhouse [dsn parse]:http_session_check: %s", err)
+				}
+			}
 		case "connection_open_strategy":
 			switch params.Get(v) {
 			case "in_order":
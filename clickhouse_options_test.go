@@ -498,6 +498,46 @@ func TestParseDSN(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"native protocol with experimental transactions",
+			"clickhouse://127.0.0.1/?experimental_transactions=true",
+			&Options{
+				Protocol:                 Native,
+				TLS:                      nil,
+				Addr:                     []string{"127.0.0.1"},
+				Settings:                 Settings{},
+				ExperimentalTransactions: true,
+				scheme:                   "clickhouse",
+			},
+			"",
+		},
+		{
+			"invalid experimental transactions",
+			"clickhouse://127.0.0.1/?experimental_transactions=yes",
+			nil,
+			"clickhouse [dsn parse]:experimental_transactions: strconv.ParseBool: parsing \"yes\": invalid syntax",
+		},
+		{
+			"http protocol with sessions",
+			"http://127.0.0.1/?http_sessions&http_session_timeout=2m&http_session_check=true",
+			&Options{
+				Protocol:           HTTP,
+				TLS:                nil,
+				Addr:               []string{"127.0.0.1"},
+				Settings:           Settings{},
+				HttpSessions:       true,
+				HttpSessionTimeout: 2 * time.Minute,
+				HttpSessionCheck:   true,
+				scheme:             "http",
+			},
+			"",
+		},
+		{
+			"invalid http session timeout",
+			"http://127.0.0.1/?http_sessions&http_session_timeout=60",
+			nil,
+			"clickhouse [dsn parse]:http_session_timeout: time: missing unit in duration \"60\"",
+		},
 	}
 
 	for _, testCase := range testCases {
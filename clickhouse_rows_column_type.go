@@ -14,6 +14,16 @@ type columnType struct {
 	scanType reflect.Type
 }
 
+// variantColumnType is the column type of Variant and Dynamic columns, which also reports the types in the block.
+type variantColumnType struct {
+	columnType
+	variantTypes []string
+}
+
+func (c *variantColumnType) VariantTypes() []string {
+	return c.variantTypes
+}
+
 func (c *columnType) Name() string {
 	return c.name
 }
@@ -34,12 +44,26 @@ func (r *rows) ColumnTypes() []driver.ColumnType {
 	types := make([]driver.ColumnType, 0, len(r.columns))
 	for i, c := range r.block.Columns {
 		_, nullable := c.(*column.Nullable)
-		types = append(types, &columnType{
+		ct := columnType{
 			name:     r.columns[i],
 			chType:   string(c.Type()),
 			nullable: nullable,
 			scanType: c.ScanType(),
-		})
+		}
+		if variant, ok := c.(interface{ VariantTypes() []column.Type }); ok {
+			variantTypes := make([]string, 0)
+			for _, t := range variant.VariantTypes() {
+				variantTypes = append(variantTypes, string(t))
+			}
+			types = append(types, &variantColumnType{columnType: ct, variantTypes: variantTypes})
+			continue
+		}
+		types = append(types, &ct)
 	}
 	return types
 }
+
+var (
+	_ driver.ColumnType        = (*columnType)(nil)
+	_ driver.VariantColumnType = (*variantColumnType)(nil)
+)
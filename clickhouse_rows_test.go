@@ -2,6 +2,7 @@ package clickhouse
 
 import (
 	"github.com/ClickHouse/clickhouse-go/v2/lib/column"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/proto"
 	"github.com/stretchr/testify/assert"
 	"strconv"
@@ -115,3 +116,57 @@ func TestReadWithEmptyBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestColumnTypesVariantTypes(t *testing.T) {
+	block := &proto.Block{ServerContext: &column.ServerContext{}}
+	assert.NoError(t, block.AddColumn("id", "Int64"))
+	assert.NoError(t, block.AddColumn("v", "Variant(Int64, String)"))
+	assert.NoError(t, block.Append(int64(1), NewVariantWithType("a", "String")))
+	assert.NoError(t, block.Append(int64(2), nil))
+
+	r := rows{block: block, columns: []string{"id", "v"}}
+	types := r.ColumnTypes()
+	_, ok := types[0].(driver.VariantColumnType)
+	assert.False(t, ok)
+	variant, ok := types[1].(driver.VariantColumnType)
+	assert.True(t, ok)
+	assert.Equal(t, "Variant(Int64, String)", variant.DatabaseTypeName())
+	assert.Equal(t, []string{"String"}, variant.VariantTypes())
+}
+
+func TestScanFlattenedNested(t *testing.T) {
+	type Item struct {
+		Name  string `ch:"name"`
+		Value uint8  `ch:"value"`
+	}
+	type Row struct {
+		ID    int64  `ch:"id"`
+		Items []Item `ch:"n"`
+	}
+
+	block := &proto.Block{ServerContext: &column.ServerContext{}}
+	assert.NoError(t, block.AddColumn("id", "Int64"))
+	assert.NoError(t, block.AddColumn("n.name", "Array(String)"))
+	assert.NoError(t, block.AddColumn("n.value", "Array(UInt8)"))
+
+	items := []Item{{Name: "a", Value: 1}, {Name: "b", Value: 2}}
+	assert.NoError(t, block.Append(int64(1), items))
+	assert.NoError(t, block.Append(int64(2), []string{"c"}, []uint8{3}))
+	assert.Error(t, block.Append(int64(3)))
+
+	r := rows{block: block, columns: block.ColumnsNames(), structMap: &structMap{}}
+	assert.True(t, r.Next())
+	var row Row
+	assert.NoError(t, r.ScanStruct(&row))
+	assert.Equal(t, Row{ID: 1, Items: items}, row)
+
+	assert.True(t, r.Next())
+	var (
+		id    int64
+		names []string
+		value []uint8
+	)
+	assert.NoError(t, r.Scan(&id, &names, &value))
+	assert.Equal(t, []string{"c"}, names)
+	assert.Equal(t, []uint8{3}, value)
+}
@@ -11,6 +11,7 @@ import (
 	"math/rand"
 	"net"
 	"reflect"
+	"regexp"
 	"sync/atomic"
 	"syscall"
 
@@ -77,6 +78,7 @@ func (o *stdConnOpener) Connect(ctx context.Context) (_ driver.Conn, err error)
 				slog.String("addr", o.opt.Addr[num]),
 			)
 			return &stdDriver{
+				opt:    o.opt,
 				conn:   conn,
 				logger: connLogger,
 			}, nil
@@ -154,15 +156,24 @@ type stdConnect interface {
 	ping(ctx context.Context) (err error)
 	prepareBatch(ctx context.Context, release nativeTransportRelease, acquire nativeTransportAcquire, query string, options chdriver.PrepareBatchOptions) (chdriver.Batch, error)
 	asyncInsert(ctx context.Context, query string, wait bool, args ...any) error
+	serverVersion() (*ServerVersion, error)
 }
 
 type stdDriver struct {
 	opt    *Options
 	conn   stdConnect
-	commit func() error
+	batch  chdriver.Batch // the INSERT batch sent on Commit
+	tx     stdTx
 	logger *slog.Logger
 }
 
+// stdTx is the state of the transaction of a connection.
+type stdTx struct {
+	open     bool
+	server   bool // a server transaction was started by BEGIN TRANSACTION
+	executed int  // statements executed in a transaction that is not a server transaction
+}
+
 var _ driver.Conn = (*stdDriver)(nil)
 var _ driver.ConnBeginTx = (*stdDriver)(nil)
 var _ driver.ExecerContext = (*stdDriver)(nil)
@@ -205,45 +216,111 @@ func (std *stdDriver) Ping(ctx context.Context) error {
 var _ driver.Pinger = (*stdDriver)(nil)
 
 func (std *stdDriver) Begin() (driver.Tx, error) {
-	if std.conn.isBad() {
-		std.logger.Debug("begin: connection is bad")
-		return nil, driver.ErrBadConn
-	}
-
-	return std, nil
+	return std.BeginTx(context.Background(), driver.TxOptions{})
 }
 
+// BeginTx starts a transaction. By default, only the INSERT batch prepared in the transaction takes part in it:
+// it is sent on Commit and discarded on Rollback, while other statements are executed immediately and cannot
+// be rolled back. With Options.ExperimentalTransactions, the transaction is a server transaction with
+// snapshot isolation. Read-only transactions and other isolation levels are rejected.
 func (std *stdDriver) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
 	if std.conn.isBad() {
 		std.logger.Debug("begin tx: connection is bad")
 		return nil, driver.ErrBadConn
 	}
 
+	transactions := std.opt != nil && std.opt.ExperimentalTransactions
+	if opts.ReadOnly {
+		return nil, ErrReadOnlyTransaction
+	}
+	switch level := sql.IsolationLevel(opts.Isolation); {
+	case level == sql.LevelDefault:
+	case level == sql.LevelSnapshot && transactions:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedIsolationLevel, level)
+	}
+
+	std.tx = stdTx{open: true}
+	if !transactions {
+		return std, nil
+	}
+	if _, ok := std.conn.(*httpConnect); ok && std.opt.Settings["session_id"] == nil && !std.opt.HttpSessions {
+		std.tx = stdTx{}
+		return nil, ErrTransactionSession
+	}
+	if err := std.conn.exec(ctx, "BEGIN TRANSACTION"); err != nil {
+		std.tx = stdTx{}
+		if isConnBrokenError(err) {
+			std.logger.Error("begin tx got a fatal error, resetting connection", slog.Any("error", err))
+			return nil, driver.ErrBadConn
+		}
+		std.logger.Error("begin tx error", slog.Any("error", err))
+		return nil, err
+	}
+	std.tx.server = true
 	return std, nil
 }
 
+// Commit sends the INSERT batch prepared in the transaction, then commits the server transaction if any.
+// If the batch cannot be sent, the server transaction is rolled back.
 func (std *stdDriver) Commit() error {
-	if std.commit == nil {
-		return nil
+	batch, tx := std.batch, std.tx
+	std.batch, std.tx = nil, stdTx{}
+
+	if batch != nil {
+		if err := batch.Send(); err != nil {
+			if tx.server && !isConnBrokenError(err) {
+				if err := std.conn.exec(context.Background(), "ROLLBACK"); err != nil {
+					std.logger.Error("rollback after failed commit error", slog.Any("error", err))
+				}
+			}
+			if isConnBrokenError(err) {
+				std.logger.Debug("commit got EOF error: resetting connection")
+				return driver.ErrBadConn
+			}
+			std.logger.Error("commit error", slog.Any("error", err))
+			return err
+		}
 	}
-	defer func() {
-		std.commit = nil
-	}()
+	if tx.server {
+		return std.endServerTx("COMMIT")
+	}
+	return nil
+}
 
-	if err := std.commit(); err != nil {
-		if isConnBrokenError(err) {
-			std.logger.Debug("commit got EOF error: resetting connection")
-			return driver.ErrBadConn
+// Rollback ends the INSERT batch prepared in the transaction without sending its rows, then rolls back the
+// server transaction if any. Without a server transaction, statements executed in the transaction cannot be
+// undone and ErrRollbackUnsupported is returned.
+func (std *stdDriver) Rollback() error {
+	batch, tx := std.batch, std.tx
+	std.batch, std.tx = nil, stdTx{}
+
+	if batch != nil {
+		if err := batch.Close(); err != nil {
+			// the connection is left within the INSERT
+			std.logger.Error("rollback error, closing connection", slog.Any("error", err))
+			std.conn.close()
+			return err
 		}
-		std.logger.Error("commit error", slog.Any("error", err))
-		return err
+	}
+	if tx.server {
+		return std.endServerTx("ROLLBACK")
+	}
+	if tx.executed > 0 {
+		return fmt.Errorf("%w: %d statement(s)", ErrRollbackUnsupported, tx.executed)
 	}
 	return nil
 }
 
-func (std *stdDriver) Rollback() error {
-	std.commit = nil
-	std.conn.close()
+func (std *stdDriver) endServerTx(statement string) error {
+	if err := std.conn.exec(context.Background(), statement); err != nil {
+		if isConnBrokenError(err) {
+			std.logger.Error("end of transaction got a fatal error, resetting connection", slog.String("statement", statement), slog.Any("error", err))
+			return driver.ErrBadConn
+		}
+		std.logger.Error("end of transaction error", slog.String("statement", statement), slog.Any("error", err))
+		return err
+	}
 	return nil
 }
 
@@ -259,12 +336,7 @@ func (std *stdDriver) ExecContext(ctx context.Context, query string, args []driv
 		return nil, driver.ErrBadConn
 	}
 
-	var err error
-	if asyncOpt := queryOptionsAsync(ctx); asyncOpt.ok {
-		err = std.conn.asyncInsert(ctx, query, asyncOpt.wait, rebind(args)...)
-	} else {
-		err = std.conn.exec(ctx, query, rebind(args)...)
-	}
+	result, err := execWithResult(ctx, std.conn, query, rebind(args)...)
 
 	if err != nil {
 		if isConnBrokenError(err) {
@@ -274,7 +346,10 @@ func (std *stdDriver) ExecContext(ctx context.Context, query string, args []driv
 		std.logger.Error("exec context error", slog.Any("error", err))
 		return nil, err
 	}
-	return driver.RowsAffected(0), nil
+	if std.tx.open && !std.tx.server {
+		std.tx.executed++
+	}
+	return stdResult{result}, nil
 }
 
 func (std *stdDriver) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
@@ -302,12 +377,22 @@ func (std *stdDriver) Prepare(query string) (driver.Stmt, error) {
 	return std.PrepareContext(context.Background(), query)
 }
 
+// PrepareContext returns a batch for an INSERT whose rows are sent by the client, see isBatchInsert.
+// Any other statement is returned as a stdStmt that binds the arguments of every execution.
 func (std *stdDriver) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
 	if std.conn.isBad() {
 		std.logger.Debug("prepare context: connection is bad")
 		return nil, driver.ErrBadConn
 	}
 
+	if !isBatchInsert(query) {
+		return &stdStmt{
+			std:              std,
+			template:         newBindTemplate(query),
+			serverParameters: hasQueryParamsRe.MatchString(query),
+		}, nil
+	}
+
 	batch, err := std.conn.prepareBatch(ctx, func(nativeTransport, error) {}, func(context.Context) (nativeTransport, error) { return nil, nil }, query, chdriver.PrepareBatchOptions{})
 	if err != nil {
 		if isConnBrokenError(err) {
@@ -317,7 +402,7 @@ func (std *stdDriver) PrepareContext(ctx context.Context, query string) (driver.
 		std.logger.Error("prepare context error", slog.Any("error", err))
 		return nil, err
 	}
-	std.commit = batch.Send
+	std.batch = batch
 	return &stdBatch{
 		batch:  batch,
 		logger: std.logger,
@@ -370,6 +455,96 @@ func (s *stdBatch) Query(args []driver.Value) (driver.Rows, error) {
 
 func (s *stdBatch) Close() error { return nil }
 
+const (
+	stdInsertPrefix = `(?i)^\s*(?:(?:--[^\n]*|#![^\n]*|#\s[^\n]*)\n\s*)*INSERT\s`
+	stdIdentifier   = "(?:`[^`]*`|\"[^\"]*\"|[\\w$]+)"
+)
+
+var (
+	stdInsertMatch = regexp.MustCompile(stdInsertPrefix)
+	// stdInsertSelectMatch matches a SELECT or WITH following the table, or table function, and column list
+	// of an INSERT, so that the same words in values, identifiers or the FORMAT data do not match
+	stdInsertSelectMatch = regexp.MustCompile(stdInsertPrefix +
+		`\s*INTO\s+(?:TABLE\s+)?` +
+		`(?:FUNCTION\s+\w+\s*\([^)]*\)|` + stdIdentifier + `(?:\s*\.\s*` + stdIdentifier + `)?)` +
+		`\s*(?:\([^)]*\))?` +
+		`\s*(?:SETTINGS\s+[^()]*?)?` +
+		`\s*\(?\s*(?:SELECT|WITH)\b`)
+)
+
+// isBatchInsert reports whether query is an INSERT of rows appended by the client,
+// as opposed to other statements and INSERT ... SELECT.
+func isBatchInsert(query string) bool {
+	return stdInsertMatch.MatchString(query) && !stdInsertSelectMatch.MatchString(query)
+}
+
+// stdStmt is a prepared statement other than a batch INSERT. The query is parsed once
+// and the arguments of every execution are bound to it on the client.
+type stdStmt struct {
+	std              *stdDriver
+	template         *bindTemplate
+	serverParameters bool // the query has {name:Type} placeholders
+}
+
+func (s *stdStmt) NumInput() int { return -1 }
+
+func (s *stdStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), namedValues(args))
+}
+
+func (s *stdStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	query, args, err := s.bind(ctx, args)
+	if err != nil {
+		s.std.logger.Error("statement bind error", slog.Any("error", err))
+		return nil, err
+	}
+	return s.std.ExecContext(ctx, query, args)
+}
+
+func (s *stdStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), namedValues(args))
+}
+
+func (s *stdStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	query, args, err := s.bind(ctx, args)
+	if err != nil {
+		s.std.logger.Error("statement bind error", slog.Any("error", err))
+		return nil, err
+	}
+	return s.std.QueryContext(ctx, query, args)
+}
+
+// bind returns the query with args bound to it. Query parameters are left to the connection, which
+// sends them to the server, along with any arguments when the context has parameters.
+func (s *stdStmt) bind(ctx context.Context, args []driver.NamedValue) (string, []driver.NamedValue, error) {
+	if len(args) == 0 || s.serverParameters || len(queryOptions(ctx).parameters) > 0 {
+		return s.template.query, args, nil
+	}
+	if s.std.conn.isBad() {
+		return "", nil, driver.ErrBadConn
+	}
+	server, err := s.std.conn.serverVersion()
+	if err != nil {
+		return "", nil, err
+	}
+	query, err := s.template.bind(server.Timezone, rebind(args)...)
+	return query, nil, err
+}
+
+func (s *stdStmt) Close() error { return nil }
+
+var _ driver.Stmt = (*stdStmt)(nil)
+var _ driver.StmtExecContext = (*stdStmt)(nil)
+var _ driver.StmtQueryContext = (*stdStmt)(nil)
+
+func namedValues(args []driver.Value) []driver.NamedValue {
+	named := make([]driver.NamedValue, len(args))
+	for i, v := range args {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: v}
+	}
+	return named
+}
+
 type stdRows struct {
 	rows   *rows
 	logger *slog.Logger
@@ -0,0 +1,142 @@
+package clickhouse
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	chdriver "github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsBatchInsert(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected bool
+	}{
+		{"INSERT INTO t", true},
+		{"insert into db.t (a, b) VALUES (?, ?)", true},
+		{"-- comment\n  INSERT INTO t FORMAT Native", true},
+		{"INSERT INTO t SELECT * FROM s WHERE a = ?", false},
+		{"INSERT INTO t (a) WITH 1 AS x SELECT x", false},
+		{"SELECT * FROM t WHERE a = ?", false},
+		{"ALTER TABLE t DELETE WHERE a = ?", false},
+		{"WITH (SELECT 1) AS x INSERT INTO t", false},
+		{"INSERT INTO t VALUES ('select', 'with')", true},
+		{"INSERT INTO `select` (`with`) VALUES (?)", true},
+		{"INSERT INTO t (selected, with_x) FORMAT Native", true},
+		{"INSERT INTO TABLE \"db\".\"t\" (a, b) SELECT a, b FROM s", false},
+		{"INSERT INTO t SETTINGS async_insert = 1 SELECT 1", false},
+		{"INSERT INTO t (SELECT 1)", false},
+		{"INSERT INTO FUNCTION remote('host', db, t) SELECT 1", false},
+		{"INSERT INTO FUNCTION remote('host', db, t) VALUES (?)", true},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, isBatchInsert(tc.query), tc.query)
+	}
+}
+
+// fakeStdConn records the statements executed on a connection.
+type fakeStdConn struct {
+	executed []string
+	batch    *fakeStdBatch
+	closed   bool
+}
+
+func (c *fakeStdConn) isBad() bool  { return c.closed }
+func (c *fakeStdConn) close() error { c.closed = true; return nil }
+func (c *fakeStdConn) query(context.Context, nativeTransportRelease, string, ...any) (*rows, error) {
+	return nil, errors.New("not implemented")
+}
+func (c *fakeStdConn) exec(_ context.Context, query string, _ ...any) error {
+	c.executed = append(c.executed, query)
+	return nil
+}
+func (c *fakeStdConn) ping(context.Context) error { return nil }
+func (c *fakeStdConn) prepareBatch(context.Context, nativeTransportRelease, nativeTransportAcquire, string, chdriver.PrepareBatchOptions) (chdriver.Batch, error) {
+	c.batch = &fakeStdBatch{}
+	return c.batch, nil
+}
+func (c *fakeStdConn) asyncInsert(context.Context, string, bool, ...any) error { return nil }
+func (c *fakeStdConn) serverVersion() (*ServerVersion, error) {
+	return &ServerVersion{Timezone: time.UTC}, nil
+}
+
+type fakeStdBatch struct {
+	chdriver.Batch
+	rows           int
+	sent, isClosed bool
+}
+
+func (b *fakeStdBatch) Append(...any) error { b.rows++; return nil }
+func (b *fakeStdBatch) Send() error         { b.sent = true; return nil }
+func (b *fakeStdBatch) Close() error        { b.isClosed = true; return nil }
+
+func TestStdTransactions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("without server transactions", func(t *testing.T) {
+		conn := &fakeStdConn{}
+		std := &stdDriver{opt: &Options{}, conn: conn, logger: newNoopLogger()}
+
+		_, err := std.BeginTx(ctx, driver.TxOptions{Isolation: driver.IsolationLevel(sql.LevelSerializable)})
+		assert.ErrorIs(t, err, ErrUnsupportedIsolationLevel)
+		_, err = std.BeginTx(ctx, driver.TxOptions{Isolation: driver.IsolationLevel(sql.LevelSnapshot)})
+		assert.ErrorIs(t, err, ErrUnsupportedIsolationLevel)
+		_, err = std.BeginTx(ctx, driver.TxOptions{ReadOnly: true})
+		assert.ErrorIs(t, err, ErrReadOnlyTransaction)
+
+		tx, err := std.BeginTx(ctx, driver.TxOptions{})
+		require.NoError(t, err)
+		stmt, err := std.PrepareContext(ctx, "INSERT INTO t")
+		require.NoError(t, err)
+		_, err = stmt.(*stdBatch).Exec([]driver.Value{1})
+		require.NoError(t, err)
+		require.NoError(t, tx.Rollback())
+		assert.True(t, conn.batch.isClosed)
+		assert.False(t, conn.batch.sent)
+		assert.False(t, conn.closed)
+
+		tx, err = std.BeginTx(ctx, driver.TxOptions{})
+		require.NoError(t, err)
+		_, err = std.ExecContext(ctx, "ALTER TABLE t DELETE WHERE 1", nil)
+		require.NoError(t, err)
+		assert.ErrorIs(t, tx.Rollback(), ErrRollbackUnsupported)
+		assert.Empty(t, std.tx)
+
+		tx, err = std.BeginTx(ctx, driver.TxOptions{})
+		require.NoError(t, err)
+		_, err = std.PrepareContext(ctx, "INSERT INTO t")
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+		assert.True(t, conn.batch.sent)
+		assert.Equal(t, []string{"ALTER TABLE t DELETE WHERE 1"}, conn.executed)
+	})
+
+	t.Run("with server transactions", func(t *testing.T) {
+		conn := &fakeStdConn{}
+		std := &stdDriver{opt: &Options{ExperimentalTransactions: true}, conn: conn, logger: newNoopLogger()}
+
+		tx, err := std.BeginTx(ctx, driver.TxOptions{Isolation: driver.IsolationLevel(sql.LevelSnapshot)})
+		require.NoError(t, err)
+		_, err = std.ExecContext(ctx, "ALTER TABLE t DELETE WHERE 1", nil)
+		require.NoError(t, err)
+		require.NoError(t, tx.Rollback())
+
+		tx, err = std.BeginTx(ctx, driver.TxOptions{})
+		require.NoError(t, err)
+		_, err = std.PrepareContext(ctx, "INSERT INTO t")
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+		assert.True(t, conn.batch.sent)
+
+		assert.Equal(t, []string{
+			"BEGIN TRANSACTION", "ALTER TABLE t DELETE WHERE 1", "ROLLBACK",
+			"BEGIN TRANSACTION", "COMMIT",
+		}, conn.executed)
+	})
+}
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	enum8Type  = "Enum8"
+	enum16Type = "Enum16"
+)
+
+var (
+	createTableRe = regexp.MustCompile("(?is)CREATE\\s+(?:OR\\s+REPLACE\\s+)?(?:TEMPORARY\\s+)?TABLE\\s+(?:IF\\s+NOT\\s+EXISTS\\s+)?([`\"\\w.]+)")
+	enumKeywordRe = regexp.MustCompile(`^(Enum(?:8|16)?)\s*\(`)
+)
+
+// generateEnumTypes returns the source of a Go package pkg declaring an EnumType for every Enum8 and Enum16 column
+// in the CREATE TABLE statements of ddl. The type of column status in table events is named EventsStatus and has
+// a constant for every member, e.g. EventsStatusActive.
+func generateEnumTypes(pkg string, ddl string) ([]byte, error) {
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by enumgen from ClickHouse DDL. DO NOT EDIT.\n\npackage %s\n\nimport \"strconv\"\n", pkg)
+
+	matches := createTableRe.FindAllStringSubmatchIndex(ddl, -1)
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("enumgen: no CREATE TABLE statement found")
+	}
+
+	for _, match := range matches {
+		table := ddl[match[2]:match[3]]
+		if i := strings.LastIndexByte(table, '.'); i != -1 {
+			table = table[i+1:]
+		}
+		table = strings.Trim(table, "`\"")
+
+		start := strings.IndexByte(ddl[match[1]:], '(')
+		if start == -1 {
+			return nil, fmt.Errorf("enumgen: no columns in table %s", table)
+		}
+		start += match[1]
+		end := closingBracket(ddl, start)
+		if end == -1 {
+			return nil, fmt.Errorf("enumgen: unbalanced brackets in table %s", table)
+		}
+
+		for _, definition := range splitDefinitions(ddl[start+1 : end]) {
+			name, chType, ok := splitColumnDefinition(definition)
+			if !ok {
+				continue
+			}
+
+			enums := findEnumTypes(chType)
+			for i, enumType := range enums {
+				typeName := goIdentifier(table) + goIdentifier(name)
+				if len(enums) > 1 {
+					typeName += strconv.Itoa(i + 1)
+				}
+
+				if err := writeEnumType(&src, typeName, fmt.Sprintf("column %s of table %s", name, table), enumType); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return format.Source(src.Bytes())
+}
+
+func writeEnumType(src *bytes.Buffer, typeName, description string, enumType string) error {
+	open := strings.IndexByte(enumType, '(')
+	typ := enumType[:open]
+	names, values, err := parseEnumMembers(enumType[open+1 : len(enumType)-1])
+	if err != nil {
+		return fmt.Errorf("enumgen: invalid enum %s in %s: %w", enumType, description, err)
+	}
+
+	goType, minValue, maxValue := "int8", math.MinInt8, math.MaxInt8
+	if typ == enum16Type {
+		goType, minValue, maxValue = "int16", math.MinInt16, math.MaxInt16
+	}
+	for i, value := range values {
+		if value < minValue || value > maxValue {
+			return fmt.Errorf("enumgen: value %d of %q is out of range in %s", value, names[i], description)
+		}
+	}
+
+	constNames := make([]string, len(names))
+	used := make(map[string]struct{}, len(names))
+	for i, name := range names {
+		constName := goIdentifier(name)
+		if constName == "" || !unicode.IsLetter([]rune(constName)[0]) {
+			constName = "Value" + constName
+		}
+		constName = typeName + constName
+		if _, ok := used[constName]; ok {
+			constName += "_" + strings.ReplaceAll(strconv.Itoa(values[i]), "-", "m")
+		}
+		used[constName] = struct{}{}
+		constNames[i] = constName
+	}
+
+	valuesVar := strings.ToLower(typeName[:1]) + typeName[1:] + "Values"
+
+	fmt.Fprintf(src, "\n// %s is the %s of %s.\ntype %s %s\n\nconst (\n", typeName, typ, description, typeName, goType)
+	for i := range names {
+		fmt.Fprintf(src, "%s %s = %d\n", constNames[i], typeName, values[i])
+	}
+	fmt.Fprintf(src, ")\n\nvar %s = map[string]int{\n", valuesVar)
+	for i, name := range names {
+		fmt.Fprintf(src, "%s: %d,\n", strconv.Quote(name), values[i])
+	}
+	fmt.Fprintf(src, "}\n\n// String returns the name of the value in ClickHouse.\nfunc (v %s) String() string {\nswitch v {\n", typeName)
+	for i, name := range names {
+		fmt.Fprintf(src, "case %s:\nreturn %s\n", constNames[i], strconv.Quote(name))
+	}
+	fmt.Fprintf(src, "}\nreturn \"%s(\" + strconv.Itoa(int(v)) + \")\"\n}\n", typeName)
+	fmt.Fprintf(src, "\n// EnumValues implements clickhouse.EnumType.\nfunc (%s) EnumValues() map[string]int {\nreturn %s\n}\n", typeName, valuesVar)
+
+	return nil
+}
+
+// splitColumnDefinition returns the name and type of a column definition, ok is false for indexes, projections and constraints.
+func splitColumnDefinition(definition string) (name string, chType string, ok bool) {
+	definition = strings.TrimSpace(definition)
+	switch keyword := strings.ToUpper(strings.SplitN(definition, " ", 2)[0]); keyword {
+	case "INDEX", "PROJECTION", "CONSTRAINT", "PRIMARY", "":
+		return "", "", false
+	}
+
+	if strings.HasPrefix(definition, "`") {
+		end := strings.IndexByte(definition[1:], '`')
+		if end == -1 {
+			return "", "", false
+		}
+		return definition[1 : end+1], strings.TrimSpace(definition[end+2:]), true
+	}
+
+	parts := strings.SplitN(definition, " ", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return strings.Trim(parts[0], "\""), strings.TrimSpace(parts[1]), true
+}
+
+// findEnumTypes returns the Enum8 and Enum16 types within a column type, e.g. in Nullable(Enum8('a' = 1)).
+// Enum types without a size are returned as Enum8 if all values fit and as Enum16 otherwise, like the server does.
+func findEnumTypes(chType string) []string {
+	var enums []string
+	for i := 0; i < len(chType); i++ {
+		if chType[i] == '\'' {
+			// skip quoted strings
+			for i++; i < len(chType) && chType[i] != '\''; i++ {
+				if chType[i] == '\\' {
+					i++
+				}
+			}
+			continue
+		}
+
+		if i > 0 && (unicode.IsLetter(rune(chType[i-1])) || unicode.IsDigit(rune(chType[i-1]))) {
+			continue
+		}
+
+		match := enumKeywordRe.FindStringSubmatch(chType[i:])
+		if match == nil {
+			continue
+		}
+
+		open := i + len(match[0]) - 1
+		end := closingBracket(chType, open)
+		if end == -1 {
+			return enums
+		}
+
+		enumType := match[1]
+		if enumType == "Enum" {
+			enumType = enum8Type
+			if _, values, err := parseEnumMembers(chType[open+1 : end]); err == nil {
+				for _, value := range values {
+					if value < math.MinInt8 || value > math.MaxInt8 {
+						enumType = enum16Type
+					}
+				}
+			}
+		}
+
+		enums = append(enums, enumType+chType[open:end+1])
+		i = end
+	}
+	return enums
+}
+
+// parseEnumMembers returns the names and values of the members of an enum, e.g. of 'a' = 1, 'b' = 2.
+// Members without a value follow the previous one, starting from 1.
+func parseEnumMembers(params string) (names []string, values []int, err error) {
+	next := 1
+	for _, member := range splitDefinitions(params) {
+		name, rest, err := unquoteString(member)
+		if err != nil {
+			return nil, nil, err
+		}
+		value := next
+		if rest = strings.TrimSpace(rest); rest != "" {
+			number, ok := strings.CutPrefix(rest, "=")
+			if !ok {
+				return nil, nil, fmt.Errorf("unexpected %q after %q", rest, name)
+			}
+			if value, err = strconv.Atoi(strings.TrimSpace(number)); err != nil {
+				return nil, nil, fmt.Errorf("invalid value of %q: %w", name, err)
+			}
+		}
+		names = append(names, name)
+		values = append(values, value)
+		next = value + 1
+	}
+	if len(names) == 0 {
+		return nil, nil, fmt.Errorf("no members")
+	}
+	return names, values, nil
+}
+
+// unquoteString returns the value of the string literal at the start of s and what follows it.
+// Quotes are escaped with a backslash or by doubling them.
+func unquoteString(s string) (value string, rest string, err error) {
+	if !strings.HasPrefix(s, "'") {
+		return "", "", fmt.Errorf("expected a string literal in %q", s)
+	}
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				b.WriteByte(s[i])
+			}
+		case c == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			b.WriteByte('\'')
+			i++
+		case c == '\'':
+			return b.String(), s[i+1:], nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", fmt.Errorf("unterminated string literal in %q", s)
+}
+
+// splitDefinitions splits s at the commas outside of brackets and string literals.
+func splitDefinitions(s string) []string {
+	var (
+		parts []string
+		start int
+		depth int
+	)
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\'':
+			for i++; i < len(s) && s[i] != '\''; i++ {
+				if s[i] == '\\' {
+					i++
+				}
+			}
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if last := strings.TrimSpace(s[start:]); last != "" {
+		parts = append(parts, last)
+	}
+	return parts
+}
+
+// closingBracket returns the index of the bracket closing the one at open, skipping quoted strings.
+func closingBracket(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '\'':
+			for i++; i < len(s) && s[i] != '\''; i++ {
+				if s[i] == '\\' {
+					i++
+				}
+			}
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// goIdentifier converts a name such as user_events or in-progress into UserEvents and InProgress.
+func goIdentifier(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
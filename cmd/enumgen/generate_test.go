@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateEnumTypes(t *testing.T) {
+	const ddl = "CREATE TABLE IF NOT EXISTS db.user_events (\n" +
+		"    id UInt64,\n" +
+		"    kind Enum ('a' = 1, 'b' = 300),\n" +
+		"    `status` Enum8('active' = 1, 'in-progress' = 2, 'it''s' = 3, '1st' = -4) DEFAULT 'active',\n" +
+		"    level Nullable(Enum16('low' = 100, 'high' = 200)),\n" +
+		"    INDEX idx status TYPE set(0) GRANULARITY 1\n" +
+		") ENGINE = MergeTree ORDER BY id"
+
+	src, err := generateEnumTypes("models", ddl)
+	require.NoError(t, err)
+	for _, expected := range []string{
+		"package models",
+		"type UserEventsStatus int8",
+		"UserEventsStatusInProgress UserEventsStatus = 2",
+		"UserEventsStatusItS        UserEventsStatus = 3",
+		"UserEventsStatusValue1st   UserEventsStatus = -4",
+		`"it's":        3,`,
+		"type UserEventsLevel int16",
+		"type UserEventsKind int16",
+		"UserEventsLevelHigh UserEventsLevel = 200",
+		"func (UserEventsLevel) EnumValues() map[string]int {",
+	} {
+		assert.Contains(t, string(src), expected)
+	}
+	assert.NotContains(t, string(src), "UserEventsId")
+
+	_, err = generateEnumTypes("models", "SELECT 1")
+	assert.Error(t, err)
+}
+
+func TestParseEnumMembers(t *testing.T) {
+	tests := []struct {
+		params string
+		names  []string
+		values []int
+	}{
+		{`'a' = 1, 'b' = 2`, []string{"a", "b"}, []int{1, 2}},
+		{`'a', 'b'`, []string{"a", "b"}, []int{1, 2}},
+		{`'a' = -3, 'b', 'c' = 10`, []string{"a", "b", "c"}, []int{-3, -2, 10}},
+		{`'it\'s' = 1, 'it''s too' = 2, 'c,d' = 3, 'e)' = 4`, []string{"it's", "it's too", "c,d", "e)"}, []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		names, values, err := parseEnumMembers(tt.params)
+		require.NoError(t, err, tt.params)
+		assert.Equal(t, tt.names, names, tt.params)
+		assert.Equal(t, tt.values, values, tt.params)
+	}
+	for _, params := range []string{"", "a = 1", "'a' 1", "'a' = x", "'a = 1"} {
+		_, _, err := parseEnumMembers(params)
+		assert.Error(t, err, params)
+	}
+}
@@ -0,0 +1,50 @@
+// Command enumgen generates Go enum types for the Enum8 and Enum16 columns of ClickHouse tables.
+// The types implement clickhouse.EnumType, so they can be scanned and appended directly and are validated
+// against the server's enum definition.
+//
+//	go run github.com/ClickHouse/clickhouse-go/v2/cmd/enumgen -pkg models -in schema.sql -out enums_gen.go
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"os"
+)
+
+var (
+	pkg = flag.String("pkg", "main", "package name of the generated file")
+	in  = flag.String("in", "", "file with CREATE TABLE statements, read from stdin if empty")
+	out = flag.String("out", "", "output file, written to stdout if empty")
+)
+
+func main() {
+	flag.Parse()
+
+	var (
+		ddl []byte
+		err error
+	)
+	if *in == "" {
+		ddl, err = io.ReadAll(os.Stdin)
+	} else {
+		ddl, err = os.ReadFile(*in)
+	}
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	src, err := generateEnumTypes(*pkg, string(ddl))
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if *out == "" {
+		_, err = os.Stdout.Write(src)
+	} else {
+		err = os.WriteFile(*out, src, 0o644)
+	}
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
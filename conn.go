@@ -121,6 +121,7 @@ type connect struct {
 	conn                 net.Conn
 	logger               *slog.Logger
 	server               ServerVersion
+	sessionTimezone      *time.Location // session_timezone of the current query, see handleTimezoneUpdate
 	closed               bool
 	buffer               *chproto.Buffer
 	reader               *chproto.Reader
@@ -390,12 +391,16 @@ func (c *connect) readData(ctx context.Context, packet byte, compressible bool)
 
 	userLocation := queryOptionsUserLocation(ctx)
 	location := c.server.Timezone
-	if userLocation != nil {
+	switch {
+	case userLocation != nil:
 		location = userLocation
+	case c.sessionTimezone != nil:
+		location = c.sessionTimezone
 	}
 
 	serverContext := serverVersionToContext(c.server)
 	serverContext.Timezone = location
+	serverContext.ZeroCopy = c.opt.ZeroCopyStrings || queryOptionsZeroCopyStrings(ctx)
 	block := proto.Block{ServerContext: &serverContext}
 	if err := block.Decode(c.reader, c.revision); err != nil {
 		c.logger.Error("read data failed: decode error",
@@ -409,7 +414,8 @@ func (c *connect) readData(ctx context.Context, packet byte, compressible bool)
 	c.logger.Debug("data block received",
 		slog.String("compression", c.compression.String()),
 		slog.Int("columns", len(block.Columns)),
-		slog.Int("rows", block.Rows()))
+		slog.Int("rows", block.Rows()),
+		slog.Any("sparse_columns", block.SparseColumns()))
 	return &block, nil
 }
 
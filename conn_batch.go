@@ -47,6 +47,11 @@ func (c *connect) prepareBatch(ctx context.Context, release nativeTransportRelea
 		release(c, err)
 		return nil, err
 	}
+	block.SparseRatio = opts.SparseSerializationRatio
+	if err = applyLowCardinalityOptions(block, opts); err != nil {
+		release(c, err)
+		return nil, err
+	}
 
 	connRelease := func(conn *connect, err error) {
 		release(conn, err)
@@ -78,6 +83,35 @@ func (c *connect) prepareBatch(ctx context.Context, release nativeTransportRelea
 	return b, nil
 }
 
+// applyLowCardinalityOptions sets up dictionary reuse and pre-seeded dictionaries of LowCardinality columns.
+func applyLowCardinalityOptions(block *proto.Block, opts driver.PrepareBatchOptions) error {
+	if !opts.ReuseLowCardinalityDictionaries && len(opts.LowCardinalityDictionaries) == 0 {
+		return nil
+	}
+	seeded := make(map[string]bool, len(opts.LowCardinalityDictionaries))
+	for i, name := range block.ColumnsNames() {
+		col, ok := block.Columns[i].(*column.LowCardinality)
+		if !ok {
+			continue
+		}
+		if opts.ReuseLowCardinalityDictionaries {
+			col.ReuseDictionary()
+		}
+		if values, ok := opts.LowCardinalityDictionaries[name]; ok {
+			if err := col.SetDictionary(values); err != nil {
+				return fmt.Errorf("dictionary of column %s: %w", name, err)
+			}
+			seeded[name] = true
+		}
+	}
+	for name := range opts.LowCardinalityDictionaries {
+		if !seeded[name] {
+			return fmt.Errorf("dictionary of column %s: not a LowCardinality column of the batch", name)
+		}
+	}
+	return nil
+}
+
 type batch struct {
 	err          error
 	ctx          context.Context
@@ -0,0 +1,70 @@
+package clickhouse
+
+import (
+	"bytes"
+	"testing"
+
+	chproto "github.com/ClickHouse/ch-go/proto"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// replayNetConn replays bytes sent by a server and discards everything written to it.
+type replayNetConn struct {
+	mockNetConn
+	data *bytes.Reader
+}
+
+func (c *replayNetConn) Read(b []byte) (int, error) {
+	return c.data.Read(b)
+}
+
+// server54465 is what a server of revision 54472 (ClickHouse 24.8) sends to a client of revision 54465:
+// the hello followed by the progress of a query.
+var server54465 = []byte{
+	proto.ServerHello,
+	0x0a, 'C', 'l', 'i', 'c', 'k', 'H', 'o', 'u', 's', 'e', // name
+	0x18,             // major version 24
+	0x08,             // minor version 8
+	0xc8, 0xa9, 0x03, // revision 54472
+	0x03, 'U', 'T', 'C', // timezone
+	0x0d, 'c', 'l', 'i', 'c', 'k', 'h', 'o', 'u', 's', 'e', '-', '0', '1', // display name
+	0x04,                                           // patch version
+	0x00,                                           // no password complexity rules
+	0x2a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // interserver secret nonce
+
+	proto.ServerProgress,
+	0xe8, 0x07, // read rows 1000
+	0xc0, 0x3e, // read bytes 8000
+	0xa0, 0x8d, 0x06, // total rows to read 100000
+	0x80, 0xea, 0x30, // total bytes to read 800000
+	0x00,             // written rows
+	0x00,             // written bytes
+	0xe0, 0xc6, 0x5b, // elapsed 1.5ms
+}
+
+func TestHandshake54465(t *testing.T) {
+	replay := &replayNetConn{data: bytes.NewReader(server54465)}
+	conn := createMockConnect(&replay.mockNetConn)
+	conn.conn, conn.reader = replay, chproto.NewReader(replay)
+
+	require.NoError(t, conn.handshake(Auth{Database: "default", Username: "default"}))
+	assert.Equal(t, "ClickHouse", conn.server.Name)
+	assert.Equal(t, "clickhouse-01", conn.server.DisplayName)
+	assert.Equal(t, proto.Version{Major: 24, Minor: 8, Patch: 4}, conn.server.Version)
+	assert.Equal(t, uint64(54472), conn.server.Revision)
+	assert.Equal(t, uint64(proto.DBMS_MIN_REVISION_WITH_SPARSE_SERIALIZATION), conn.revision)
+
+	packet, err := conn.reader.ReadByte()
+	require.NoError(t, err)
+	require.Equal(t, byte(proto.ServerProgress), packet)
+	progress, err := conn.progress()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000), progress.Rows)
+	assert.Equal(t, uint64(8000), progress.Bytes)
+	assert.Equal(t, uint64(100000), progress.TotalRows)
+	assert.Equal(t, uint64(800000), progress.TotalBytes)
+	assert.EqualValues(t, 1500000, progress.Elapsed)
+	assert.Zero(t, replay.data.Len())
+}
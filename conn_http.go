@@ -7,6 +7,7 @@ import (
 	"compress/zlib"
 	"context"
 	sqldriver "database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -23,7 +24,9 @@ import (
 	"github.com/ClickHouse/ch-go/compress"
 	chproto "github.com/ClickHouse/ch-go/proto"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/proto"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/timezone"
 	"github.com/andybalholm/brotli"
+	"github.com/google/uuid"
 )
 
 const (
@@ -191,6 +194,13 @@ func dialHttp(ctx context.Context, addr string, num int, opt *Options) (*httpCon
 
 	query.Set("default_format", "Native")
 	query.Set("client_protocol_version", strconv.Itoa(ClientTCPProtocolVersion))
+	if opt.HttpSessions {
+		query.Set("session_id", uuid.NewString())
+		if opt.HttpSessionTimeout > 0 {
+			timeout := (opt.HttpSessionTimeout + time.Second - 1) / time.Second
+			query.Set("session_timeout", strconv.FormatInt(int64(timeout), 10))
+		}
+	}
 	u.RawQuery = query.Encode()
 
 	rt, err := createHTTPRoundTripper(opt)
@@ -224,6 +234,12 @@ func dialHttp(ctx context.Context, addr string, num int, opt *Options) (*httpCon
 	conn.handshake = handshake
 	conn.revision = conn.handshake.Revision
 
+	if opt.HttpSessions && opt.HttpSessionCheck {
+		// the session is created by the hello query, it must exist from now on
+		query.Set("session_check", "1")
+		conn.url.RawQuery = query.Encode()
+	}
+
 	return &conn, nil
 }
 
@@ -411,7 +427,7 @@ func (h *httpConnect) writeData(block *proto.Block) error {
 	return nil
 }
 
-func (h *httpConnect) readData(reader *chproto.Reader, timezone *time.Location, captureBuffer *bytes.Buffer) (*proto.Block, error) {
+func (h *httpConnect) readData(reader *chproto.Reader, timezone *time.Location, zeroCopyStrings bool, captureBuffer *bytes.Buffer) (*proto.Block, error) {
 	location := h.handshake.Timezone
 	if timezone != nil {
 		location = timezone
@@ -419,6 +435,7 @@ func (h *httpConnect) readData(reader *chproto.Reader, timezone *time.Location,
 
 	serverContext := serverVersionToContext(h.handshake)
 	serverContext.Timezone = location
+	serverContext.ZeroCopy = h.opt.ZeroCopyStrings || zeroCopyStrings
 	block := proto.Block{ServerContext: &serverContext}
 	if h.compression == CompressionLZ4 || h.compression == CompressionZSTD {
 		reader.EnableCompression()
@@ -564,6 +581,7 @@ func (h *httpConnect) sendStreamQuery(ctx context.Context, r io.Reader, options
 	if err != nil {
 		return nil, err
 	}
+	options.reportResponse(res.Header)
 
 	return res, nil
 }
@@ -578,6 +596,7 @@ func (h *httpConnect) sendQuery(ctx context.Context, query string, options *Quer
 	if err != nil {
 		return nil, err
 	}
+	options.reportResponse(res.Header)
 	return res, nil
 }
 
@@ -710,6 +729,11 @@ func (h *httpConnect) executeRequest(req *http.Request) (*http.Response, error)
 			return nil, fmt.Errorf("[HTTP %d] failed to read response: %w", resp.StatusCode, err)
 		}
 
+		if h.opt.HttpSessionCheck && bytes.Contains(msgBytes, []byte("SESSION_NOT_FOUND")) {
+			// the session expired, the connection cannot be used anymore
+			h.logger.Warn("HTTP session not found, closing connection")
+			h.close()
+		}
 		return nil, fmt.Errorf("[HTTP %d] response body: \"%s\"", resp.StatusCode, string(msgBytes))
 	}
 	return resp, nil
@@ -746,3 +770,125 @@ func discardAndClose(rc io.ReadCloser) {
 	_, _ = io.Copy(io.Discard, rc)
 	_ = rc.Close()
 }
+
+// reportResponse reports the metadata in the headers of a response to the callbacks of the query.
+//
+// The X-ClickHouse-Progress headers, sent with the send_progress_in_http_headers setting, and the
+// X-ClickHouse-Summary header hold the cumulative progress of the query. They are reported to the progress
+// callback as increments, like the progress packets of the native protocol, so that the sum of the reported
+// progress is the summary. The HTTP client receives the headers together when the response starts, so the
+// progress of a query that streams its result is reported before its first block only.
+// The result rows and bytes of the summary are reported to the profile info callback.
+func (q *QueryOptions) reportResponse(header http.Header) {
+	var reported httpQuerySummary
+	reportProgress := func(s *httpQuerySummary) {
+		if delta := s.since(&reported); q.events.progress != nil && !delta.isZero() {
+			q.events.progress(delta.progress())
+		}
+		reported = *s
+	}
+	for _, value := range header.Values("X-ClickHouse-Progress") {
+		if progress, ok := parseHTTPSummary(value); ok {
+			reportProgress(progress)
+		}
+	}
+
+	summary, ok := parseHTTPSummary(header.Get("X-ClickHouse-Summary"))
+	if ok {
+		reportProgress(summary)
+		if q.events.profileInfo != nil && (summary.resultRows != 0 || summary.resultBytes != 0) {
+			q.events.profileInfo(&ProfileInfo{
+				Rows:  summary.resultRows,
+				Bytes: summary.resultBytes,
+			})
+		}
+	}
+	if q.events.metadata != nil {
+		metadata := ResultMetadata{
+			QueryID:           header.Get("X-ClickHouse-Query-Id"),
+			Format:            header.Get("X-ClickHouse-Format"),
+			ServerDisplayName: header.Get("X-ClickHouse-Server-Display-Name"),
+		}
+		if name := header.Get("X-ClickHouse-Timezone"); name != "" {
+			metadata.Timezone, _ = timezone.Load(name)
+		}
+		if ok {
+			metadata.Summary = summary.progress()
+		}
+		q.events.metadata(&metadata)
+	}
+}
+
+// httpQuerySummary is the content of the X-ClickHouse-Summary and X-ClickHouse-Progress headers.
+type httpQuerySummary struct {
+	readRows, readBytes       uint64
+	totalRowsToRead           uint64
+	totalBytesToRead          uint64
+	writtenRows, writtenBytes uint64
+	resultRows, resultBytes   uint64
+	elapsed                   time.Duration
+}
+
+func (s *httpQuerySummary) progress() *Progress {
+	return &Progress{
+		Rows:       s.readRows,
+		Bytes:      s.readBytes,
+		TotalRows:  s.totalRowsToRead,
+		TotalBytes: s.totalBytesToRead,
+		WroteRows:  s.writtenRows,
+		WroteBytes: s.writtenBytes,
+		Elapsed:    s.elapsed,
+	}
+}
+
+// since returns the progress made after prev. Values that did not grow are zero.
+func (s *httpQuerySummary) since(prev *httpQuerySummary) httpQuerySummary {
+	sub := func(v, prev uint64) uint64 {
+		if v < prev {
+			return 0
+		}
+		return v - prev
+	}
+	return httpQuerySummary{
+		readRows:         sub(s.readRows, prev.readRows),
+		readBytes:        sub(s.readBytes, prev.readBytes),
+		totalRowsToRead:  sub(s.totalRowsToRead, prev.totalRowsToRead),
+		totalBytesToRead: sub(s.totalBytesToRead, prev.totalBytesToRead),
+		writtenRows:      sub(s.writtenRows, prev.writtenRows),
+		writtenBytes:     sub(s.writtenBytes, prev.writtenBytes),
+		resultRows:       sub(s.resultRows, prev.resultRows),
+		resultBytes:      sub(s.resultBytes, prev.resultBytes),
+		elapsed:          time.Duration(sub(uint64(s.elapsed), uint64(prev.elapsed))),
+	}
+}
+
+func (s *httpQuerySummary) isZero() bool {
+	return *s == httpQuerySummary{}
+}
+
+// parseHTTPSummary parses the value of a X-ClickHouse-Summary or X-ClickHouse-Progress header,
+// e.g. {"read_rows":"0","written_rows":"10",...}.
+func parseHTTPSummary(value string) (*httpQuerySummary, bool) {
+	if value == "" {
+		return nil, false
+	}
+	var summary map[string]string
+	if err := json.Unmarshal([]byte(value), &summary); err != nil {
+		return nil, false
+	}
+	field := func(name string) uint64 {
+		v, _ := strconv.ParseUint(summary[name], 10, 64)
+		return v
+	}
+	return &httpQuerySummary{
+		readRows:         field("read_rows"),
+		readBytes:        field("read_bytes"),
+		totalRowsToRead:  field("total_rows_to_read"),
+		totalBytesToRead: field("total_bytes_to_read"),
+		writtenRows:      field("written_rows"),
+		writtenBytes:     field("written_bytes"),
+		resultRows:       field("result_rows"),
+		resultBytes:      field("result_bytes"),
+		elapsed:          time.Duration(field("elapsed_ns")),
+	}, true
+}
@@ -110,6 +110,10 @@ func (h *httpConnect) prepareBatch(ctx context.Context, release nativeTransportR
 		release(h, err)
 		return nil, err
 	}
+	if err := applyLowCardinalityOptions(block, opts); err != nil {
+		release(h, err)
+		return nil, err
+	}
 
 	return &httpBatch{
 		ctx:         ctx,
@@ -79,7 +79,7 @@ func (h *httpConnect) query(ctx context.Context, release nativeTransportRelease,
 	capturingRdr := &capturingReader{reader: reader}
 	bufferedReader := bufio.NewReader(capturingRdr)
 	chReader := chproto.NewReader(bufferedReader)
-	block, err := h.readData(chReader, options.userLocation, &capturingRdr.buffer)
+	block, err := h.readData(chReader, options.userLocation, options.zeroCopyStrings, &capturingRdr.buffer)
 	if err != nil && !errors.Is(err, io.EOF) {
 		err = fmt.Errorf("readData: %w", err)
 		discardAndClose(res.Body)
@@ -99,7 +99,7 @@ func (h *httpConnect) query(ctx context.Context, release nativeTransportRelease,
 	)
 	go func() {
 		for {
-			block, err := h.readData(chReader, options.userLocation, &capturingRdr.buffer)
+			block, err := h.readData(chReader, options.userLocation, options.zeroCopyStrings, &capturingRdr.buffer)
 			if err != nil {
 				// ch-go wraps EOF errors
 				if !errors.Is(err, io.EOF) {
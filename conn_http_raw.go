@@ -0,0 +1,110 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+
+	chproto "github.com/ClickHouse/ch-go/proto"
+)
+
+// rawResult is the undecoded result of a query. Closing it releases the connection.
+type rawResult struct {
+	io.Reader
+	close func() error
+	once  sync.Once
+	err   error
+}
+
+func (r *rawResult) Close() error {
+	r.once.Do(func() {
+		r.err = r.close()
+	})
+	return r.err
+}
+
+func (h *httpConnect) queryRaw(ctx context.Context, release nativeTransportRelease, query, format string) (io.ReadCloser, error) {
+	h.logger.Debug("HTTP raw query", slog.String("sql", query), slog.String("format", format))
+	options := queryOptions(ctx)
+	options.settings["default_format"] = format
+	headers := make(map[string]string)
+	switch h.compression {
+	case CompressionZSTD, CompressionLZ4:
+		options.settings["compress"] = "1"
+	case CompressionGZIP, CompressionDeflate, CompressionBrotli:
+		headers["Accept-Encoding"] = h.compression.String()
+	}
+
+	res, err := h.sendQuery(ctx, query, &options, headers)
+	if err != nil {
+		err = fmt.Errorf("sendQuery: %w", err)
+		release(h, err)
+		return nil, err
+	}
+
+	rw := h.compressionPool.Get()
+	reader, err := rw.NewReader(res)
+	if err != nil {
+		err = fmt.Errorf("NewReader: %w", err)
+		discardAndClose(res.Body)
+		h.compressionPool.Put(rw)
+		release(h, err)
+		return nil, err
+	}
+	if h.compression == CompressionLZ4 || h.compression == CompressionZSTD {
+		chReader := chproto.NewReader(reader)
+		chReader.EnableCompression()
+		reader = chReader
+	}
+
+	return &rawResult{
+		Reader: reader,
+		close: func() error {
+			// the rest of the result is not read, closing the body drops the HTTP connection if needed
+			err := res.Body.Close()
+			h.compressionPool.Put(rw)
+			release(h, nil)
+			return err
+		},
+	}, nil
+}
+
+func (h *httpConnect) insertRaw(ctx context.Context, query string, data io.Reader) error {
+	options := queryOptions(ctx)
+	headers := map[string]string{
+		"Content-Type": "application/octet-stream",
+	}
+	switch h.compression {
+	case CompressionGZIP, CompressionDeflate, CompressionBrotli:
+		headers["Content-Encoding"] = h.compression.String()
+	}
+
+	compressionWriter := h.compressionPool.Get()
+	pipeReader, pipeWriter := io.Pipe()
+	connWriter := compressionWriter.reset(pipeWriter)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := io.Copy(connWriter, data); err != nil {
+			// fail the request instead of inserting truncated data
+			pipeWriter.CloseWithError(err)
+			return
+		}
+		pipeWriter.CloseWithError(connWriter.Close())
+	}()
+
+	options.settings["query"] = query
+	h.logger.Debug("HTTP raw insert", slog.String("sql", query))
+	res, err := h.sendStreamQuery(ctx, pipeReader, &options, headers)
+	// unblocks the writer if the request failed before the data was sent
+	pipeReader.Close()
+	<-done
+	h.compressionPool.Put(compressionWriter)
+	if err != nil {
+		return fmt.Errorf("insertRaw sendStreamQuery: %w", err)
+	}
+	discardAndClose(res.Body)
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 
 	"github.com/ClickHouse/clickhouse-go/v2/lib/proto"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/timezone"
 )
 
 type onProcess struct {
@@ -204,6 +205,8 @@ func (c *connect) handle(ctx context.Context, packet byte, on *onProcess) error
 			return err
 		}
 		on.logs(logs)
+	case proto.ServerTimezoneUpdate:
+		return c.handleTimezoneUpdate()
 	case proto.ServerProgress:
 		progress, err := c.progress()
 		if err != nil {
@@ -220,6 +223,29 @@ func (c *connect) handle(ctx context.Context, packet byte, on *onProcess) error
 	return nil
 }
 
+// handleTimezoneUpdate reads the session_timezone of the query, sent by the server before the result.
+// An empty timezone means the timezone of the server.
+func (c *connect) handleTimezoneUpdate() error {
+	name, err := c.reader.Str()
+	if err != nil {
+		return err
+	}
+	c.logger.Debug("timezone update received", slog.String("timezone", name))
+	if name == "" {
+		c.sessionTimezone = nil
+		return nil
+	}
+	location, err := timezone.Load(name)
+	if err != nil {
+		return &OpError{
+			Op:  "process",
+			Err: fmt.Errorf("timezone update: %w", err),
+		}
+	}
+	c.sessionTimezone = location
+	return nil
+}
+
 func (c *connect) cancel() error {
 	c.logger.Debug("cancelling query")
 	c.buffer.PutUVarInt(proto.ClientCancel)
@@ -0,0 +1,16 @@
+package clickhouse
+
+import (
+	"context"
+	"io"
+)
+
+// insertRaw sends data inline after the INSERT ... FORMAT query, which the server parses as the data of the insert.
+// The native protocol has no way to stream data in a ClickHouse format, so data is read into memory first.
+func (c *connect) insertRaw(ctx context.Context, query string, data io.Reader) error {
+	body, err := io.ReadAll(data)
+	if err != nil {
+		return err
+	}
+	return c.exec(ctx, query+"\n"+string(body))
+}
@@ -12,6 +12,7 @@ func (c *connect) sendQuery(body string, o *QueryOptions) error {
 	c.logger.Debug("sending query",
 		slog.String("compression", c.compression.String()),
 		slog.String("query", body))
+	c.sessionTimezone = nil
 	c.buffer.PutByte(proto.ClientQuery)
 	q := proto.Query{
 		ClientTCPProtocolVersion: ClientTCPProtocolVersion,
@@ -49,6 +49,7 @@ type (
 			progress      func(*Progress)
 			profileInfo   func(*ProfileInfo)
 			profileEvents func([]ProfileEvent)
+			metadata      func(*ResultMetadata)
 		}
 		settings            Settings
 		parameters          Parameters
@@ -57,6 +58,7 @@ type (
 		userLocation        *time.Location
 		columnNamesAndTypes []ColumnNameAndType
 		clientInfo          ClientInfo
+		zeroCopyStrings     bool
 	}
 )
 
@@ -139,6 +141,11 @@ func WithLogs(fn func(*Log)) QueryOption {
 	}
 }
 
+// WithProgress sets a callback receiving the progress of the query as increments.
+// Over the native protocol, the progress is reported while the query runs. Over HTTP, it is read from the
+// X-ClickHouse-Progress headers, sent with the send_progress_in_http_headers setting, which the client only
+// receives when the response starts: they are all reported at once before the first block of the result,
+// not as live progress.
 func WithProgress(fn func(*Progress)) QueryOption {
 	return func(o *QueryOptions) error {
 		o.events.progress = fn
@@ -160,6 +167,31 @@ func WithProfileEvents(fn func([]ProfileEvent)) QueryOption {
 	}
 }
 
+// ResultMetadata is the metadata of a query result reported by the server in the HTTP response headers.
+type ResultMetadata struct {
+	// QueryID is the ID of the query, the one set with WithQueryID or generated by the server.
+	QueryID string
+	// Format is the output format of the response.
+	Format string
+	// Timezone is the timezone of the server session, nil if unknown.
+	Timezone *time.Location
+	// ServerDisplayName is the display_name of the server that executed the query.
+	ServerDisplayName string
+	// Summary is the progress of the query when the response started, nil if not reported.
+	// It has the totals of the query only if it was executed with the wait_end_of_query setting,
+	// or if the response has no data, e.g. for INSERT.
+	Summary *Progress
+}
+
+// WithResultMetadata sets a callback receiving the metadata of the query result.
+// Only the HTTP protocol reports result metadata, once per query, before any row is read.
+func WithResultMetadata(fn func(*ResultMetadata)) QueryOption {
+	return func(o *QueryOptions) error {
+		o.events.metadata = fn
+		return nil
+	}
+}
+
 func WithExternalTable(t ...*ext.Table) QueryOption {
 	return func(o *QueryOptions) error {
 		o.external = append(o.external, t...)
@@ -189,6 +221,17 @@ func WithUserLocation(location *time.Location) QueryOption {
 	}
 }
 
+// WithZeroCopyStrings makes String and FixedString columns scan into *[]byte and *string without copying.
+// Scanned values are views of the block buffer and are only valid until the next block is read,
+// so they must not be retained or modified; copy them if they are needed for longer.
+// See also Options.ZeroCopyStrings.
+func WithZeroCopyStrings() QueryOption {
+	return func(o *QueryOptions) error {
+		o.zeroCopyStrings = true
+		return nil
+	}
+}
+
 func ignoreExternalTables() QueryOption {
 	return func(o *QueryOptions) error {
 		o.external = nil
@@ -270,6 +313,15 @@ func queryOptionsUserLocation(ctx context.Context) *time.Location {
 	return nil
 }
 
+// queryOptionsZeroCopyStrings reports whether zero-copy string scanning was requested in the given context's QueryOptions.
+func queryOptionsZeroCopyStrings(ctx context.Context) bool {
+	if opt, ok := ctx.Value(_contextOptionKey).(QueryOptions); ok {
+		return opt.zeroCopyStrings
+	}
+
+	return false
+}
+
 func (q *QueryOptions) onProcess() *onProcess {
 	onProcess := &onProcess{
 		logs: func(logs []Log) {
@@ -315,6 +367,7 @@ func (q *QueryOptions) clone() QueryOptions {
 		blockBufferSize:     q.blockBufferSize,
 		userLocation:        q.userLocation,
 		columnNamesAndTypes: nil,
+		zeroCopyStrings:     q.zeroCopyStrings,
 	}
 
 	if q.settings != nil {
@@ -0,0 +1,100 @@
+package clickhouse_api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/shopspring/decimal"
+)
+
+// money is a number of cents stored as a Decimal(18, 2)
+type money int64
+
+func (m money) MarshalColumn() (any, error) {
+	return decimal.New(int64(m), -2), nil
+}
+
+func (m *money) UnmarshalColumn(v any) error {
+	amount, ok := v.(decimal.Decimal)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal %T into money", v)
+	}
+	*m = money(amount.Shift(2).IntPart())
+	return nil
+}
+
+// tenantID is stored as a prefixed String
+type tenantID string
+
+func (t tenantID) MarshalColumn() (any, error) {
+	return "tenant:" + string(t), nil
+}
+
+func (t *tenantID) UnmarshalColumn(v any) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal %T into tenantID", v)
+	}
+	*t = tenantID(strings.TrimPrefix(s, "tenant:"))
+	return nil
+}
+
+var (
+	_ clickhouse.ColumnMarshaler   = money(0)
+	_ clickhouse.ColumnUnmarshaler = (*money)(nil)
+)
+
+func CustomMarshalerTypes() error {
+	conn, err := GetNativeConnection(nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	defer func() {
+		conn.Exec(context.Background(), "DROP TABLE example")
+	}()
+	if err := conn.Exec(ctx, `DROP TABLE IF EXISTS example`); err != nil {
+		return err
+	}
+	err = conn.Exec(ctx, `
+		CREATE TABLE example (
+			  Tenant   String,
+			  Amount   Decimal(18, 2),
+			  Payments Array(Decimal(18, 2)),
+			  Balances Map(String, Decimal(18, 2))
+		) Engine = Memory
+	`)
+	if err != nil {
+		return err
+	}
+
+	batch, err := conn.PrepareBatch(ctx, "INSERT INTO example")
+	if err != nil {
+		return err
+	}
+	if err := batch.Append(
+		tenantID("acme"),
+		money(1050),
+		[]money{100, 200},
+		map[tenantID]money{"acme": 1050},
+	); err != nil {
+		return err
+	}
+	if err := batch.Send(); err != nil {
+		return err
+	}
+
+	var (
+		tenant   tenantID
+		amount   money
+		payments []money
+		balances map[tenantID]money
+	)
+	if err := conn.QueryRow(ctx, "SELECT * FROM example").Scan(&tenant, &amount, &payments, &balances); err != nil {
+		return err
+	}
+	fmt.Printf("tenant=%s, amount=%d, payments=%v, balances=%v\n", tenant, amount, payments, balances)
+	return nil
+}
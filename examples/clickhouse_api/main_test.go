@@ -123,6 +123,10 @@ func TestCustomTypes(t *testing.T) {
 	require.NoError(t, CustomTypes())
 }
 
+func TestCustomMarshalerTypes(t *testing.T) {
+	require.NoError(t, CustomMarshalerTypes())
+}
+
 func TestDynamicScan(t *testing.T) {
 	require.NoError(t, DynamicScan())
 }
@@ -135,27 +135,32 @@ func NestedFlattened() error {
 	if err != nil {
 		return err
 	}
+	type Col1 struct {
+		Col1_1 string `ch:"Col1_1"`
+		Col1_2 uint8  `ch:"Col1_2"`
+	}
+	type Col2_2 struct {
+		Col2_2_1 uint8 `ch:"Col2_2_1"`
+		Col2_2_2 uint8 `ch:"Col2_2_2"`
+	}
+	type Col2 struct {
+		Col2_1 uint8    `ch:"Col2_1"`
+		Col2_2 []Col2_2 `ch:"Col2_2"`
+	}
 	var i uint8
 	for i = 0; i < 10; i++ {
-		col1_1_data := []string{strconv.Itoa(int(i)), strconv.Itoa(int(i + 1)), strconv.Itoa(int(i + 2))}
-		col1_2_data := []uint8{i, i + 1, i + 2}
-		col2_1_data := []uint8{i, i + 1, i + 2}
-		col2_2_data := [][][]any{
-			{
-				{i, i + 1},
-			},
-			{
-				{i + 2, i + 3},
+		// the Array columns the server flattens a Nested column into can be appended as a slice of structs or maps
+		err := batch.Append(
+			[]Col1{
+				{Col1_1: strconv.Itoa(int(i)), Col1_2: i},
+				{Col1_1: strconv.Itoa(int(i + 1)), Col1_2: i + 1},
+				{Col1_1: strconv.Itoa(int(i + 2)), Col1_2: i + 2},
 			},
-			{
-				{i + 4, i + 5},
+			[]Col2{
+				{Col2_1: i, Col2_2: []Col2_2{{Col2_2_1: i, Col2_2_2: i + 1}}},
+				{Col2_1: i + 1, Col2_2: []Col2_2{{Col2_2_1: i + 2, Col2_2_2: i + 3}}},
+				{Col2_1: i + 2, Col2_2: []Col2_2{{Col2_2_1: i + 4, Col2_2_2: i + 5}}},
 			},
-		}
-		err := batch.Append(
-			col1_1_data,
-			col1_2_data,
-			col2_1_data,
-			col2_2_data,
 		)
 		if err != nil {
 			return err
@@ -164,5 +169,22 @@ func NestedFlattened() error {
 	if err := batch.Send(); err != nil {
 		return err
 	}
-	return nil
+
+	rows, err := conn.Query(ctx, "SELECT * FROM example")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			col1 []Col1
+			col2 []Col2
+		)
+		if err := rows.Scan(&col1, &col2); err != nil {
+			return err
+		}
+		fmt.Printf("row: col1=%v, col2=%v\n", col1, col2)
+	}
+	return rows.Err()
 }
@@ -0,0 +1,80 @@
+package clickhouse
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/google/uuid"
+)
+
+// ExecWithResult executes the statement on conn like Exec and returns its query ID and the progress reported by
+// the server, e.g. the rows and bytes written by an INSERT. A query ID is generated unless the context has one.
+//
+// conn must implement driver.ResultExecer, as the connections returned by Open do,
+// otherwise an error wrapping errors.ErrUnsupported is returned.
+func ExecWithResult(ctx context.Context, conn driver.Conn, query string, args ...any) (driver.ExecResult, error) {
+	execer, ok := conn.(driver.ResultExecer)
+	if !ok {
+		return driver.ExecResult{}, errUnsupportedConn(conn, "driver.ResultExecer")
+	}
+	return execer.ExecWithResult(ctx, query, args...)
+}
+
+// execer is implemented by the native and HTTP connections.
+type execer interface {
+	exec(ctx context.Context, query string, args ...any) error
+	asyncInsert(ctx context.Context, query string, wait bool, args ...any) error
+}
+
+// execWithResult executes query like Exec and sums up the progress reported by the server into the result.
+// A query ID is generated unless the context has one.
+func execWithResult(ctx context.Context, conn execer, query string, args ...any) (driver.ExecResult, error) {
+	var (
+		options = queryOptions(ctx)
+		result  = driver.ExecResult{QueryID: options.queryID}
+		elapsed time.Duration
+	)
+	if result.QueryID == "" {
+		result.QueryID = uuid.NewString()
+	}
+	ctx = Context(ctx, WithQueryID(result.QueryID), WithProgress(func(p *Progress) {
+		result.Summary.ReadRows += p.Rows
+		result.Summary.ReadBytes += p.Bytes
+		result.Summary.WrittenRows += p.WroteRows
+		result.Summary.WrittenBytes += p.WroteBytes
+		elapsed += p.Elapsed
+		if options.events.progress != nil {
+			options.events.progress(p)
+		}
+	}))
+
+	var (
+		start = time.Now()
+		err   error
+	)
+	if options.async.ok {
+		err = conn.asyncInsert(ctx, query, options.async.wait, args...)
+	} else {
+		err = conn.exec(ctx, query, args...)
+	}
+	result.Summary.Elapsed = elapsed
+	if elapsed == 0 {
+		result.Summary.Elapsed = time.Since(start)
+	}
+	return result, err
+}
+
+// stdResult is the sql.Result of a statement, the affected rows are the rows it wrote.
+type stdResult struct {
+	driver.ExecResult
+}
+
+func (r stdResult) LastInsertId() (int64, error) {
+	return 0, errors.New("LastInsertId is not supported by ClickHouse")
+}
+
+func (r stdResult) RowsAffected() (int64, error) {
+	return int64(r.Summary.WrittenRows), nil
+}
@@ -0,0 +1,142 @@
+package clickhouse
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeExecer reports progress like a native connection.
+type fakeExecer struct {
+	queryID  string
+	async    bool
+	progress []*Progress
+}
+
+func (e *fakeExecer) exec(ctx context.Context, query string, args ...any) error {
+	options := queryOptions(ctx)
+	e.queryID = options.queryID
+	for _, p := range e.progress {
+		options.events.progress(p)
+	}
+	return nil
+}
+
+func (e *fakeExecer) asyncInsert(ctx context.Context, query string, wait bool, args ...any) error {
+	e.async = true
+	return e.exec(ctx, query, args...)
+}
+
+func TestExecWithResult(t *testing.T) {
+	conn := &fakeExecer{progress: []*Progress{
+		{Rows: 10, Bytes: 100},
+		{WroteRows: 5, WroteBytes: 50, Elapsed: time.Millisecond},
+		{WroteRows: 5, WroteBytes: 50, Elapsed: time.Millisecond},
+	}}
+	var reported int
+	ctx := Context(context.Background(), WithProgress(func(*Progress) { reported++ }))
+
+	result, err := execWithResult(ctx, conn, "INSERT INTO t SELECT 1")
+	require.NoError(t, err)
+	assert.Equal(t, conn.queryID, result.QueryID)
+	assert.NotEmpty(t, result.QueryID)
+	assert.Equal(t, uint64(10), result.Summary.ReadRows)
+	assert.Equal(t, uint64(100), result.Summary.ReadBytes)
+	assert.Equal(t, uint64(10), result.Summary.WrittenRows)
+	assert.Equal(t, uint64(100), result.Summary.WrittenBytes)
+	assert.Equal(t, 2*time.Millisecond, result.Summary.Elapsed)
+	assert.Equal(t, 3, reported)
+	assert.False(t, conn.async)
+
+	affected, err := stdResult{result}.RowsAffected()
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), affected)
+	_, err = stdResult{result}.LastInsertId()
+	assert.Error(t, err)
+
+	conn.progress = nil
+	result, err = execWithResult(Context(ctx, WithQueryID("q1"), WithAsync(false)), conn, "INSERT INTO t VALUES (1)")
+	require.NoError(t, err)
+	assert.Equal(t, "q1", result.QueryID)
+	assert.True(t, conn.async)
+	assert.Positive(t, result.Summary.Elapsed)
+}
+
+func TestHTTPReportResponse(t *testing.T) {
+	var (
+		progress    []*Progress
+		profileInfo []*ProfileInfo
+		metadata    []*ResultMetadata
+	)
+	options := queryOptions(Context(context.Background(),
+		WithProgress(func(p *Progress) { progress = append(progress, p) }),
+		WithProfileInfo(func(p *ProfileInfo) { profileInfo = append(profileInfo, p) }),
+		WithResultMetadata(func(m *ResultMetadata) { metadata = append(metadata, m) }),
+	))
+
+	header := http.Header{}
+	header.Set("X-ClickHouse-Query-Id", "q1")
+	options.reportResponse(header)
+	assert.Empty(t, progress)
+	assert.Empty(t, profileInfo)
+	require.Len(t, metadata, 1)
+	assert.Equal(t, &ResultMetadata{QueryID: "q1"}, metadata[0])
+
+	header.Set("X-ClickHouse-Format", "Native")
+	header.Set("X-ClickHouse-Timezone", "Europe/Berlin")
+	header.Set("X-ClickHouse-Server-Display-Name", "node-1")
+	header.Set("X-ClickHouse-Summary", `{"read_rows":"3","read_bytes":"24","written_rows":"2","written_bytes":"16","total_rows_to_read":"3","result_rows":"2","result_bytes":"16","elapsed_ns":"1500"}`)
+	options.reportResponse(header)
+	expected := &Progress{Rows: 3, Bytes: 24, TotalRows: 3, WroteRows: 2, WroteBytes: 16, Elapsed: 1500}
+	assert.Equal(t, []*Progress{expected}, progress)
+	assert.Equal(t, []*ProfileInfo{{Rows: 2, Bytes: 16}}, profileInfo)
+	require.Len(t, metadata, 2)
+	assert.Equal(t, "q1", metadata[1].QueryID)
+	assert.Equal(t, "Native", metadata[1].Format)
+	assert.Equal(t, "node-1", metadata[1].ServerDisplayName)
+	require.NotNil(t, metadata[1].Timezone)
+	assert.Equal(t, "Europe/Berlin", metadata[1].Timezone.String())
+	assert.Equal(t, expected, metadata[1].Summary)
+
+	_, ok := parseHTTPSummary("invalid")
+	assert.False(t, ok)
+}
+
+func TestHTTPReportProgressHeaders(t *testing.T) {
+	var progress []*Progress
+	options := queryOptions(Context(context.Background(), WithProgress(func(p *Progress) {
+		progress = append(progress, p)
+	})))
+
+	header := http.Header{}
+	header.Add("X-ClickHouse-Progress", `{"read_rows":"0","read_bytes":"0","total_rows_to_read":"100","elapsed_ns":"100"}`)
+	header.Add("X-ClickHouse-Progress", `{"read_rows":"40","read_bytes":"320","total_rows_to_read":"100","elapsed_ns":"300"}`)
+	header.Add("X-ClickHouse-Progress", `{"read_rows":"40","read_bytes":"320","total_rows_to_read":"100","elapsed_ns":"300"}`)
+	header.Add("X-ClickHouse-Progress", "invalid")
+	header.Set("X-ClickHouse-Summary", `{"read_rows":"100","read_bytes":"800","total_rows_to_read":"100","elapsed_ns":"1000"}`)
+	options.reportResponse(header)
+	assert.Equal(t, []*Progress{
+		{TotalRows: 100, Elapsed: 100},
+		{Rows: 40, Bytes: 320, Elapsed: 200},
+		{Rows: 60, Bytes: 480, Elapsed: 700},
+	}, progress)
+
+	var total Progress
+	for _, p := range progress {
+		total.Rows += p.Rows
+		total.Bytes += p.Bytes
+	}
+	assert.Equal(t, uint64(100), total.Rows)
+	assert.Equal(t, uint64(800), total.Bytes)
+}
+
+func TestExecWithResult_UnsupportedConn(t *testing.T) {
+	_, err := ExecWithResult(context.Background(), plainConn{}, "SELECT 1")
+	assert.ErrorIs(t, err, errors.ErrUnsupported)
+	assert.ErrorContains(t, err, "driver.ResultExecer")
+}
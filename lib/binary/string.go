@@ -7,3 +7,7 @@ import (
 func unsafeStr2Bytes(str string) []byte {
 	return unsafe.Slice(unsafe.StringData(str), len(str))
 }
+
+func unsafeBytes2Str(b []byte) string {
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
@@ -13,3 +13,8 @@ func Str2Bytes(str string, expectedLen int) []byte {
 
 	return b
 }
+
+// Bytes2Str returns b as a string. On this platform the bytes are copied.
+func Bytes2Str(b []byte) string {
+	return string(b)
+}
@@ -14,3 +14,8 @@ func Str2Bytes(str string, expectedLen int) []byte {
 
 	return b
 }
+
+// Bytes2Str returns a string sharing memory with b. The bytes must not be modified while the string is in use.
+func Bytes2Str(b []byte) string {
+	return unsafeBytes2Str(b)
+}
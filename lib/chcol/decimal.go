@@ -0,0 +1,19 @@
+package chcol
+
+import (
+	"github.com/ClickHouse/ch-go/proto"
+)
+
+// Decimal64 is a fixed-point decimal stored as an unscaled integer and its scale, i.e. Value * 10^-Scale.
+// Decimal columns can be scanned into and appended from it without allocating.
+type Decimal64 struct {
+	Value int64
+	Scale uint8
+}
+
+// Decimal128 is a fixed-point decimal stored as an unscaled 128-bit integer and its scale, i.e. Value * 10^-Scale.
+// Value is in two's complement like the Decimal128 values of ClickHouse.
+type Decimal128 struct {
+	Value proto.Int128
+	Scale uint8
+}
@@ -0,0 +1,92 @@
+package chcol
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// IntervalUnit is the unit of a ClickHouse Interval type, e.g. Minute for IntervalMinute
+type IntervalUnit string
+
+const (
+	IntervalNanosecond  IntervalUnit = "Nanosecond"
+	IntervalMicrosecond IntervalUnit = "Microsecond"
+	IntervalMillisecond IntervalUnit = "Millisecond"
+	IntervalSecond      IntervalUnit = "Second"
+	IntervalMinute      IntervalUnit = "Minute"
+	IntervalHour        IntervalUnit = "Hour"
+	IntervalDay         IntervalUnit = "Day"
+	IntervalWeek        IntervalUnit = "Week"
+	IntervalMonth       IntervalUnit = "Month"
+	IntervalQuarter     IntervalUnit = "Quarter"
+	IntervalYear        IntervalUnit = "Year"
+)
+
+var intervalUnitDurations = map[IntervalUnit]time.Duration{
+	IntervalNanosecond:  time.Nanosecond,
+	IntervalMicrosecond: time.Microsecond,
+	IntervalMillisecond: time.Millisecond,
+	IntervalSecond:      time.Second,
+	IntervalMinute:      time.Minute,
+	IntervalHour:        time.Hour,
+}
+
+// Valid reports whether u is one of the units supported by ClickHouse
+func (u IntervalUnit) Valid() bool {
+	switch u {
+	case IntervalNanosecond, IntervalMicrosecond, IntervalMillisecond, IntervalSecond, IntervalMinute, IntervalHour,
+		IntervalDay, IntervalWeek, IntervalMonth, IntervalQuarter, IntervalYear:
+		return true
+	}
+	return false
+}
+
+// Duration returns the length of one unit. ok is false for calendar units (Day and longer),
+// whose length depends on the date they are applied to.
+func (u IntervalUnit) Duration() (d time.Duration, ok bool) {
+	d, ok = intervalUnitDurations[u]
+	return d, ok
+}
+
+// Interval represents a value of a ClickHouse Interval type, e.g. toIntervalMinute(5)
+type Interval struct {
+	Value int64
+	Unit  IntervalUnit
+}
+
+// NewInterval converts d into an Interval of the given sub-day unit.
+// It returns an error if unit is a calendar unit or d is not a whole number of units.
+func NewInterval(d time.Duration, unit IntervalUnit) (Interval, error) {
+	size, ok := unit.Duration()
+	if !ok {
+		return Interval{}, fmt.Errorf("cannot convert time.Duration to interval unit %s", unit)
+	}
+	if d%size != 0 {
+		return Interval{}, fmt.Errorf("%s is not a whole number of %ss", d, strings.ToLower(string(unit)))
+	}
+	return Interval{Value: int64(d / size), Unit: unit}, nil
+}
+
+// Duration returns the interval as a time.Duration.
+// It returns an error for calendar units (Day and longer) or if the interval overflows time.Duration.
+func (i Interval) Duration() (time.Duration, error) {
+	size, ok := i.Unit.Duration()
+	if !ok {
+		return 0, fmt.Errorf("interval unit %s has no fixed duration", i.Unit)
+	}
+	if i.Value > math.MaxInt64/int64(size) || i.Value < math.MinInt64/int64(size) {
+		return 0, fmt.Errorf("%s overflows time.Duration", i)
+	}
+	return time.Duration(i.Value) * size, nil
+}
+
+// String returns the interval in the form ClickHouse prints it, e.g. "5 Minutes"
+func (i Interval) String() string {
+	v := fmt.Sprintf("%d %s", i.Value, i.Unit)
+	if i.Value > 1 {
+		v += "s"
+	}
+	return v
+}
@@ -1,10 +1,13 @@
 package chcol
 
 import (
+	"bytes"
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"io"
 	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -83,16 +86,27 @@ func (o *JSON) ValueAtPath(path string) (any, bool) {
 
 // NestedMap converts the flattened JSON data into a nested structure
 func (o *JSON) NestedMap() map[string]any {
-	result := make(map[string]any)
+	sortedPaths := o.sortedPaths()
+	return nestPaths(sortedPaths, func(i int) any {
+		return o.valuesByPath[sortedPaths[i]]
+	})
+}
 
+func (o *JSON) sortedPaths() []string {
 	sortedPaths := make([]string, 0, len(o.valuesByPath))
 	for path := range o.valuesByPath {
 		sortedPaths = append(sortedPaths, path)
 	}
 	slices.Sort(sortedPaths)
 
-	for _, path := range sortedPaths {
-		value := o.valuesByPath[path]
+	return sortedPaths
+}
+
+func nestPaths(sortedPaths []string, valueAt func(i int) any) map[string]any {
+	result := make(map[string]any)
+
+	for i, path := range sortedPaths {
+		value := valueAt(i)
 		if vt, ok := value.(Variant); ok && vt.Nil() {
 			continue
 		}
@@ -117,9 +131,315 @@ func (o *JSON) NestedMap() map[string]any {
 	return result
 }
 
+// EncodeJSON writes the value as a nested JSON object to w with its keys in sorted order,
+// without building the intermediate maps of NestedMap.
+func (o *JSON) EncodeJSON(w io.Writer) error {
+	sortedPaths := o.sortedPaths()
+	return EncodeJSONPaths(w, sortedPaths, func(i int) any {
+		return o.valuesByPath[sortedPaths[i]]
+	})
+}
+
 // MarshalJSON implements the json.Marshaler interface
 func (o *JSON) MarshalJSON() ([]byte, error) {
-	return json.Marshal(o.NestedMap())
+	var buf bytes.Buffer
+	if err := o.EncodeJSON(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Objects are flattened into paths as they are read,
+// integers are stored as int64 (uint64 if out of range), other numbers as float64 and arrays of a single scalar type
+// as a slice of that type. null values are omitted.
+func (o *JSON) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	if token, err := dec.Token(); err != nil {
+		return err
+	} else if token != json.Delim('{') {
+		return fmt.Errorf("JSON value must be an object, got %v", token)
+	}
+
+	o.valuesByPath = make(map[string]any)
+	if err := o.decodeObject(dec, ""); err != nil {
+		return err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("unexpected data after JSON object")
+	}
+
+	return nil
+}
+
+// decodeObject reads the members of an object which opening brace has been read, up to and including its closing brace
+func (o *JSON) decodeObject(dec *json.Decoder, prefix string) error {
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		path := token.(string)
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+
+		token, err = dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch token {
+		case json.Delim('{'):
+			if err := o.decodeObject(dec, path); err != nil {
+				return err
+			}
+		case json.Delim('['):
+			values, err := decodeJSONArray(dec)
+			if err != nil {
+				return err
+			}
+			o.valuesByPath[path] = typedJSONArray(values)
+		case nil:
+		default:
+			o.valuesByPath[path] = jsonTokenValue(token)
+		}
+	}
+
+	_, err := dec.Token()
+	return err
+}
+
+// decodeJSONArray reads the elements of an array which opening bracket has been read, nested objects are read as map[string]any.
+func decodeJSONArray(dec *json.Decoder) ([]any, error) {
+	values := []any{}
+	for dec.More() {
+		var value any
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		values = append(values, jsonNumbers(value))
+	}
+
+	_, err := dec.Token()
+	return values, err
+}
+
+// typedJSONArray converts arrays of a single scalar type into a slice of that type, e.g. []int64, so they can be
+// stored in a Dynamic column. Integers mixed with floats become []float64.
+func typedJSONArray(values []any) any {
+	if len(values) == 0 {
+		return values
+	}
+
+	var ints, floats, strs, bools int
+	for _, value := range values {
+		switch value.(type) {
+		case int64:
+			ints++
+		case float64:
+			floats++
+		case string:
+			strs++
+		case bool:
+			bools++
+		}
+	}
+
+	switch len(values) {
+	case ints:
+		return convertJSONArray[int64](values)
+	case strs:
+		return convertJSONArray[string](values)
+	case bools:
+		return convertJSONArray[bool](values)
+	case ints + floats:
+		result := make([]float64, len(values))
+		for i, value := range values {
+			switch v := value.(type) {
+			case int64:
+				result[i] = float64(v)
+			case float64:
+				result[i] = v
+			}
+		}
+		return result
+	}
+
+	return values
+}
+
+func convertJSONArray[T any](values []any) []T {
+	result := make([]T, len(values))
+	for i, value := range values {
+		result[i] = value.(T)
+	}
+
+	return result
+}
+
+func jsonNumbers(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		return jsonTokenValue(v)
+	case []any:
+		for i := range v {
+			v[i] = jsonNumbers(v[i])
+		}
+	case map[string]any:
+		for key := range v {
+			v[key] = jsonNumbers(v[key])
+		}
+	}
+
+	return value
+}
+
+func jsonTokenValue(token json.Token) any {
+	number, ok := token.(json.Number)
+	if !ok {
+		return token
+	}
+
+	if v, err := strconv.ParseInt(string(number), 10, 64); err == nil {
+		return v
+	}
+
+	if v, err := strconv.ParseUint(string(number), 10, 64); err == nil {
+		return v
+	}
+
+	v, _ := number.Float64()
+	return v
+}
+
+// EncodeJSONPaths writes the values of the sorted, dot separated paths as a nested JSON object to w.
+// valueAt returns the value of the path at index i, paths holding a NULL Variant or Dynamic are omitted.
+func EncodeJSONPaths(w io.Writer, sortedPaths []string, valueAt func(i int) any) error {
+	if hasPathConflict(sortedPaths, valueAt) {
+		// a path holds both a value and nested values, merge them the same way as NestedMap
+		data, err := json.Marshal(nestPaths(sortedPaths, valueAt))
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(data)
+		return err
+	}
+
+	enc := &jsonPathsEncoder{w: w}
+	enc.write("{")
+	enc.members = append(enc.members, false)
+
+	var open []string
+	for i, path := range sortedPaths {
+		value := valueAt(i)
+		if vt, ok := value.(Variant); ok && vt.Nil() {
+			continue
+		}
+
+		parts := strings.Split(path, ".")
+		common := 0
+		for common < len(open) && common < len(parts)-1 && open[common] == parts[common] {
+			common++
+		}
+
+		for len(open) > common {
+			enc.write("}")
+			open = open[:len(open)-1]
+			enc.members = enc.members[:len(enc.members)-1]
+		}
+
+		for _, part := range parts[len(open) : len(parts)-1] {
+			enc.key(part)
+			enc.write("{")
+			open = append(open, part)
+			enc.members = append(enc.members, false)
+		}
+
+		enc.key(parts[len(parts)-1])
+		enc.value(value)
+		if enc.err != nil {
+			return enc.err
+		}
+	}
+
+	for range open {
+		enc.write("}")
+	}
+	enc.write("}")
+
+	return enc.err
+}
+
+// hasPathConflict returns true if the parent of a path also holds a non-NULL value.
+func hasPathConflict(sortedPaths []string, valueAt func(i int) any) bool {
+	for _, path := range sortedPaths {
+		for end := strings.IndexByte(path, '.'); end != -1; end = nextDot(path, end) {
+			i, found := slices.BinarySearch(sortedPaths, path[:end])
+			if !found {
+				continue
+			}
+
+			if vt, ok := valueAt(i).(Variant); ok && vt.Nil() {
+				continue
+			}
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func nextDot(path string, after int) int {
+	if i := strings.IndexByte(path[after+1:], '.'); i != -1 {
+		return after + 1 + i
+	}
+
+	return -1
+}
+
+type jsonPathsEncoder struct {
+	w       io.Writer
+	err     error
+	members []bool // whether each open object has members written already
+}
+
+func (e *jsonPathsEncoder) write(s string) {
+	if e.err == nil {
+		_, e.err = io.WriteString(e.w, s)
+	}
+}
+
+func (e *jsonPathsEncoder) key(key string) {
+	if last := len(e.members) - 1; e.members[last] {
+		e.write(",")
+	} else {
+		e.members[last] = true
+	}
+
+	e.value(key)
+	e.write(":")
+}
+
+func (e *jsonPathsEncoder) value(v any) {
+	if e.err != nil {
+		return
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		e.err = err
+		return
+	}
+
+	_, e.err = e.w.Write(data)
 }
 
 // Scan implements the sql.Scanner interface
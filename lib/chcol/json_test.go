@@ -1,6 +1,7 @@
 package chcol
 
 import (
+	"bytes"
 	"encoding/json"
 	"testing"
 
@@ -100,3 +101,41 @@ func TestJSONMarshal(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, objStr, jsonStr)
 }
+
+func TestJSONEncode(t *testing.T) {
+	obj := &JSON{
+		valuesByPath: map[string]any{
+			"b.y":   NewVariant("text"),
+			"b.x":   NewVariant([]int64{1, 2}),
+			"a":     NewVariant(nil),
+			"a-b":   int64(1),
+			"c.d.e": NewVariant(true),
+			"c.f":   nil,
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, obj.EncodeJSON(&buf))
+	require.Equal(t, `{"a-b":1,"b":{"x":[1,2],"y":"text"},"c":{"d":{"e":true},"f":null}}`, buf.String())
+
+	expected, err := json.Marshal(obj.NestedMap())
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), buf.String())
+}
+
+func TestJSONUnmarshal(t *testing.T) {
+	obj := NewJSON()
+	require.NoError(t, json.Unmarshal([]byte(`{"a":{"b":1,"c":{"d":"x"}},"e":[1,2.5,{"f":3}],"g":null,"h":true,"i":18446744073709551615,"j":{},"k":[1,2],"l":[1,2.5]}`), obj))
+	require.Equal(t, map[string]any{
+		"a.b":   int64(1),
+		"a.c.d": "x",
+		"e":     []any{int64(1), 2.5, map[string]any{"f": int64(3)}},
+		"k":     []int64{1, 2},
+		"l":     []float64{1, 2.5},
+		"h":     true,
+		"i":     uint64(18446744073709551615),
+	}, obj.ValuesByPath())
+
+	require.Error(t, json.Unmarshal([]byte(`[1]`), obj))
+	require.Error(t, json.Unmarshal([]byte(`{"a":}`), obj))
+}
@@ -0,0 +1,140 @@
+package chcol
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeOfDay is a civil time of day from 00:00:00 to 23:59:59.999999999, e.g. an opening hour.
+// Use it instead of time.Duration for Time and Time64 values that are clock times rather than durations.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+const day = 24 * time.Hour
+
+// NewTimeOfDay returns the time of day from the given clock fields. It returns an error if any field is out of range.
+func NewTimeOfDay(hour, minute, second, nanosecond int) (TimeOfDay, error) {
+	t := TimeOfDay{Hour: hour, Minute: minute, Second: second, Nanosecond: nanosecond}
+	if !t.Valid() {
+		return TimeOfDay{}, fmt.Errorf("invalid time of day %02d:%02d:%02d.%09d", hour, minute, second, nanosecond)
+	}
+	return t, nil
+}
+
+// TimeOfDayOf returns the clock time of t in its location.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	hour, minute, second := t.Clock()
+	return TimeOfDay{Hour: hour, Minute: minute, Second: second, Nanosecond: t.Nanosecond()}
+}
+
+// TimeOfDayFromDuration returns the time of day d after midnight.
+// It returns an error if d is negative or not less than 24 hours.
+func TimeOfDayFromDuration(d time.Duration) (TimeOfDay, error) {
+	if d < 0 || d >= day {
+		return TimeOfDay{}, fmt.Errorf("%s is not a time of day", d)
+	}
+	return TimeOfDay{
+		Hour:       int(d / time.Hour),
+		Minute:     int(d % time.Hour / time.Minute),
+		Second:     int(d % time.Minute / time.Second),
+		Nanosecond: int(d % time.Second),
+	}, nil
+}
+
+// ParseTimeOfDay parses a time of day in the form ClickHouse prints it, e.g. "09:30:00" or "17:45:30.250".
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	clock, fraction, hasFraction := strings.Cut(strings.TrimSpace(s), ".")
+	parts := strings.Split(clock, ":")
+	if len(parts) != 3 || (hasFraction && (fraction == "" || len(fraction) > 9)) {
+		return TimeOfDay{}, fmt.Errorf("invalid time of day %q", s)
+	}
+
+	var fields [3]int
+	for i, part := range parts {
+		v, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return TimeOfDay{}, fmt.Errorf("invalid time of day %q", s)
+		}
+		fields[i] = int(v)
+	}
+
+	var nanosecond int
+	if hasFraction {
+		v, err := strconv.ParseUint(fraction+strings.Repeat("0", 9-len(fraction)), 10, 32)
+		if err != nil {
+			return TimeOfDay{}, fmt.Errorf("invalid time of day %q", s)
+		}
+		nanosecond = int(v)
+	}
+
+	t := TimeOfDay{Hour: fields[0], Minute: fields[1], Second: fields[2], Nanosecond: nanosecond}
+	if !t.Valid() {
+		return TimeOfDay{}, fmt.Errorf("invalid time of day %q", s)
+	}
+	return t, nil
+}
+
+// Valid reports whether all fields are within their range.
+func (t TimeOfDay) Valid() bool {
+	return t.Hour >= 0 && t.Hour < 24 &&
+		t.Minute >= 0 && t.Minute < 60 &&
+		t.Second >= 0 && t.Second < 60 &&
+		t.Nanosecond >= 0 && t.Nanosecond < int(time.Second)
+}
+
+// Duration returns the time elapsed since midnight.
+func (t TimeOfDay) Duration() time.Duration {
+	return time.Duration(t.Hour)*time.Hour +
+		time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second +
+		time.Duration(t.Nanosecond)
+}
+
+// On returns the time at t on the date of d in the location of d.
+func (t TimeOfDay) On(d time.Time) time.Time {
+	year, month, dom := d.Date()
+	return time.Date(year, month, dom, t.Hour, t.Minute, t.Second, t.Nanosecond, d.Location())
+}
+
+// String returns the time of day as HH:MM:SS, followed by the fractional seconds without trailing zeros if any.
+func (t TimeOfDay) String() string {
+	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+	if t.Nanosecond != 0 {
+		s += strings.TrimRight(fmt.Sprintf(".%09d", t.Nanosecond), "0")
+	}
+	return s
+}
+
+// Scan implements sql.Scanner for time.Duration, time.Time and string values.
+func (t *TimeOfDay) Scan(src any) (err error) {
+	switch v := src.(type) {
+	case nil:
+		*t = TimeOfDay{}
+	case time.Duration:
+		*t, err = TimeOfDayFromDuration(v)
+	case time.Time:
+		*t = TimeOfDayOf(v)
+	case string:
+		*t, err = ParseTimeOfDay(v)
+	case []byte:
+		*t, err = ParseTimeOfDay(string(v))
+	default:
+		return fmt.Errorf("cannot scan %T into TimeOfDay", src)
+	}
+	return err
+}
+
+// Value implements driver.Valuer, the time of day is bound as a string.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	if !t.Valid() {
+		return nil, fmt.Errorf("invalid time of day %02d:%02d:%02d.%09d", t.Hour, t.Minute, t.Second, t.Nanosecond)
+	}
+	return t.String(), nil
+}
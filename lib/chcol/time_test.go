@@ -0,0 +1,59 @@
+package chcol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeOfDay(t *testing.T) {
+	tod, err := NewTimeOfDay(17, 45, 30, 250_000_000)
+	require.NoError(t, err)
+	assert.Equal(t, "17:45:30.25", tod.String())
+	assert.Equal(t, 17*time.Hour+45*time.Minute+30*time.Second+250*time.Millisecond, tod.Duration())
+
+	fromDuration, err := TimeOfDayFromDuration(tod.Duration())
+	require.NoError(t, err)
+	assert.Equal(t, tod, fromDuration)
+
+	parsed, err := ParseTimeOfDay("17:45:30.250")
+	require.NoError(t, err)
+	assert.Equal(t, tod, parsed)
+
+	date := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 3, 1, 17, 45, 30, 250_000_000, time.UTC), tod.On(date))
+	assert.Equal(t, TimeOfDay{Hour: 8}, TimeOfDayOf(date))
+
+	value, err := TimeOfDay{Hour: 9, Minute: 30}.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "09:30:00", value)
+}
+
+func TestTimeOfDayInvalid(t *testing.T) {
+	_, err := NewTimeOfDay(24, 0, 0, 0)
+	assert.Error(t, err)
+
+	for _, d := range []time.Duration{-time.Second, 24 * time.Hour} {
+		_, err := TimeOfDayFromDuration(d)
+		assert.Error(t, err, d)
+	}
+
+	for _, s := range []string{"", "12:00", "25:00:00", "12:60:00", "-01:00:00", "12:00:00.", "12:00:00.1234567890"} {
+		_, err := ParseTimeOfDay(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestTimeOfDayScan(t *testing.T) {
+	var tod TimeOfDay
+	require.NoError(t, tod.Scan(9*time.Hour+15*time.Minute))
+	assert.Equal(t, TimeOfDay{Hour: 9, Minute: 15}, tod)
+
+	require.NoError(t, tod.Scan([]byte("23:59:59.999999999")))
+	assert.Equal(t, TimeOfDay{Hour: 23, Minute: 59, Second: 59, Nanosecond: 999_999_999}, tod)
+
+	assert.Error(t, tod.Scan(-time.Minute))
+	assert.Error(t, tod.Scan(42))
+}
@@ -3,6 +3,7 @@ package chcol
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"strings"
 )
 
 // Variant represents a ClickHouse Variant type that can hold multiple possible types
@@ -55,6 +56,38 @@ func (v Variant) Any() any {
 	return v.value
 }
 
+// VariantCases maps ClickHouse type names to the handlers called by Switch.
+// A type name without parameters, e.g. "DateTime64", matches all of its parameterized types.
+// "NULL" matches NULL values and "" matches any value without a more specific case.
+type VariantCases map[string]func(v Variant) error
+
+// Switch calls the handler in cases matching the ClickHouse type of the value and returns its error.
+// The exact type name is matched first, then the name without parameters and then the default case "".
+// Nothing is called when no case matches.
+func (v Variant) Switch(cases VariantCases) error {
+	if v.Nil() {
+		if handler, ok := cases["NULL"]; ok {
+			return handler(v)
+		}
+	} else if v.chType != "" {
+		if handler, ok := cases[v.chType]; ok {
+			return handler(v)
+		}
+
+		if i := strings.IndexByte(v.chType, '('); i > 0 {
+			if handler, ok := cases[v.chType[:i]]; ok {
+				return handler(v)
+			}
+		}
+	}
+
+	if handler, ok := cases[""]; ok {
+		return handler(v)
+	}
+
+	return nil
+}
+
 // Scan implements the sql.Scanner interface
 func (v *Variant) Scan(value interface{}) error {
 	switch vv := value.(type) {
@@ -0,0 +1,50 @@
+package chcol
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariantSwitch(t *testing.T) {
+	var matched string
+	cases := VariantCases{
+		"String": func(v Variant) error {
+			matched = "String:" + v.Any().(string)
+			return nil
+		},
+		"DateTime64": func(v Variant) error {
+			matched = "DateTime64"
+			return nil
+		},
+		"Array(Int64)": func(v Variant) error {
+			return errors.New("array")
+		},
+		"NULL": func(v Variant) error {
+			matched = "NULL"
+			return nil
+		},
+		"": func(v Variant) error {
+			matched = "default:" + v.Type()
+			return nil
+		},
+	}
+
+	require.NoError(t, NewVariantWithType("text", "String").Switch(cases))
+	require.Equal(t, "String:text", matched)
+	require.NoError(t, NewVariantWithType(nil, "").Switch(cases))
+	require.Equal(t, "NULL", matched)
+	require.NoError(t, NewVariantWithType(int64(0), "DateTime64(3)").Switch(cases))
+	require.Equal(t, "DateTime64", matched)
+	require.NoError(t, NewVariantWithType(int64(1), "Int64").Switch(cases))
+	require.Equal(t, "default:Int64", matched)
+	require.EqualError(t, NewVariantWithType([]int64{1}, "Array(Int64)").Switch(cases), "array")
+
+	matched = ""
+	require.NoError(t, NewVariant(1).Switch(VariantCases{"Int64": func(Variant) error {
+		matched = "Int64"
+		return nil
+	}}))
+	require.Empty(t, matched)
+}
@@ -0,0 +1,328 @@
+package column
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ClickHouse/ch-go/proto"
+)
+
+// AggregateFunction reads and writes the intermediate states of aggregate functions, e.g. AggregateFunction(uniq, String).
+// States are exposed as opaque []byte in the server's binary format and can be inserted back as is.
+// For common functions (count, sum, min, max, any, anyLast, avg, uniqExact, groupArray) states can also be
+// scanned into the value they represent.
+//
+// The size of a state is only known from its format, so columns of other functions, e.g. quantiles, argMax or
+// sumMap, fail with UnsupportedAggregateFunctionError. A codec for them can be added with Register.
+type AggregateFunction struct {
+	chType   Type
+	name     string
+	function string
+	args     []Type
+	state    aggregateState
+	data     []byte
+	offsets  []int
+}
+
+// UnsupportedAggregateFunctionError is returned for AggregateFunction columns of a function
+// whose state format is unknown to the driver. It unwraps to an UnsupportedColumnTypeError.
+type UnsupportedAggregateFunctionError struct {
+	Function string
+	t        Type
+}
+
+func (e *UnsupportedAggregateFunctionError) Error() string {
+	return fmt.Sprintf("clickhouse: unsupported column type %q: the state format of aggregate function %s is unknown, "+
+		"register a codec for it with column.Register", e.t, e.Function)
+}
+
+func (e *UnsupportedAggregateFunctionError) Unwrap() error {
+	return &UnsupportedColumnTypeError{t: e.t}
+}
+
+func (col *AggregateFunction) Reset() {
+	col.data = col.data[:0]
+	col.offsets = col.offsets[:0]
+}
+
+func (col *AggregateFunction) Name() string {
+	return col.name
+}
+
+func (col *AggregateFunction) parse(t Type) (_ Interface, err error) {
+	col.chType = t
+	params := splitTypeParams(t.params())
+	if len(params) != 0 {
+		// versioned states are prefixed with the version number e.g. AggregateFunction(1, sumMap, ...)
+		if _, err := strconv.Atoi(params[0]); err == nil {
+			params = params[1:]
+		}
+	}
+	if len(params) == 0 {
+		return nil, &UnsupportedColumnTypeError{
+			t: t,
+		}
+	}
+	col.function = params[0]
+	if idx := strings.Index(col.function, "("); idx > 0 {
+		col.function = strings.TrimSpace(col.function[:idx])
+	}
+	for _, arg := range params[1:] {
+		col.args = append(col.args, Type(arg))
+	}
+	state, ok := newAggregateState(col.function, col.args)
+	if !ok {
+		return nil, &UnsupportedAggregateFunctionError{
+			Function: col.function,
+			t:        t,
+		}
+	}
+	col.state = state
+	return col, nil
+}
+
+// Function returns the name of the aggregate function.
+func (col *AggregateFunction) Function() string {
+	return col.function
+}
+
+func (col *AggregateFunction) Type() Type {
+	return col.chType
+}
+
+func (col *AggregateFunction) ScanType() reflect.Type {
+	return scanTypeByte
+}
+
+func (col *AggregateFunction) Rows() int {
+	return len(col.offsets)
+}
+
+func (col *AggregateFunction) rawState(i int) []byte {
+	start := 0
+	if i > 0 {
+		start = col.offsets[i-1]
+	}
+	return col.data[start:col.offsets[i]]
+}
+
+func (col *AggregateFunction) Row(i int, ptr bool) any {
+	value := bytes.Clone(col.rawState(i))
+	if ptr {
+		return &value
+	}
+	return value
+}
+
+// Value decodes the state at row i into the value it represents.
+func (col *AggregateFunction) Value(i int) (any, error) {
+	if col.state.decode == nil {
+		return nil, &Error{
+			ColumnType: string(col.chType),
+			Err:        fmt.Errorf("decoding %s states is not supported, scan into *[]byte", col.function),
+		}
+	}
+	return col.state.decode(col.rawState(i))
+}
+
+func (col *AggregateFunction) ScanRow(dest any, i int) error {
+	switch d := dest.(type) {
+	case *[]byte:
+		*d = bytes.Clone(col.rawState(i))
+		return nil
+	case **[]byte:
+		*d = new([]byte)
+		**d = bytes.Clone(col.rawState(i))
+		return nil
+	case *any:
+		*d = bytes.Clone(col.rawState(i))
+		return nil
+	}
+	if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+		return unmarshaler.UnmarshalColumn(bytes.Clone(col.rawState(i)))
+	}
+	if col.state.decode != nil {
+		value, err := col.Value(i)
+		if err != nil {
+			return err
+		}
+		if scan, ok := dest.(sql.Scanner); ok {
+			return scan.Scan(value)
+		}
+		target := reflect.ValueOf(dest)
+		if target.Kind() != reflect.Pointer || target.IsNil() {
+			return &ColumnConverterError{
+				Op:   "ScanRow",
+				To:   fmt.Sprintf("%T", dest),
+				From: string(col.chType),
+			}
+		}
+		elem := target.Elem()
+		if value == nil {
+			elem.Set(reflect.Zero(elem.Type()))
+			return nil
+		}
+		rv := reflect.ValueOf(value)
+		if elem.Kind() == reflect.Pointer {
+			if !rv.CanConvert(elem.Type().Elem()) {
+				return &ColumnConverterError{
+					Op:   "ScanRow",
+					To:   fmt.Sprintf("%T", dest),
+					From: string(col.chType),
+					Hint: fmt.Sprintf("try using *%T", value),
+				}
+			}
+			ptr := reflect.New(elem.Type().Elem())
+			ptr.Elem().Set(rv.Convert(elem.Type().Elem()))
+			elem.Set(ptr)
+			return nil
+		}
+		if rv.CanConvert(elem.Type()) {
+			elem.Set(rv.Convert(elem.Type()))
+			return nil
+		}
+		return &ColumnConverterError{
+			Op:   "ScanRow",
+			To:   fmt.Sprintf("%T", dest),
+			From: string(col.chType),
+			Hint: fmt.Sprintf("try using *%T or *[]byte", value),
+		}
+	}
+	if scan, ok := dest.(sql.Scanner); ok {
+		return scan.Scan(bytes.Clone(col.rawState(i)))
+	}
+	return &ColumnConverterError{
+		Op:   "ScanRow",
+		To:   fmt.Sprintf("%T", dest),
+		From: string(col.chType),
+		Hint: "try using *[]byte",
+	}
+}
+
+func (col *AggregateFunction) Append(v any) (nulls []uint8, err error) {
+	switch v := v.(type) {
+	case [][]byte:
+		nulls = make([]uint8, len(v))
+		for i := range v {
+			if err := col.AppendRow(v[i]); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+		return nil, &ColumnConverterError{
+			Op:   "Append",
+			To:   string(col.chType),
+			From: fmt.Sprintf("%T", v),
+			Hint: "try using [][]byte",
+		}
+	}
+	return
+}
+
+func (col *AggregateFunction) AppendRow(v any) error {
+	var state []byte
+	switch v := v.(type) {
+	case []byte:
+		state = v
+	case *[]byte:
+		if v != nil {
+			state = *v
+		}
+	case string:
+		state = []byte(v)
+	default:
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+		if valuer, ok := v.(driver.Valuer); ok {
+			val, err := valuer.Value()
+			if err != nil {
+				return &ColumnConverterError{
+					Op:   "AppendRow",
+					To:   string(col.chType),
+					From: fmt.Sprintf("%T", v),
+					Hint: "could not get driver.Valuer value",
+				}
+			}
+			return col.AppendRow(val)
+		}
+		return &ColumnConverterError{
+			Op:   "AppendRow",
+			To:   string(col.chType),
+			From: fmt.Sprintf("%T", v),
+			Hint: "try using []byte",
+		}
+	}
+	// validate the state so a malformed one can't corrupt the rest of the block
+	reader := &stateReader{r: bytes.NewReader(state)}
+	if err := col.state.read(reader); err != nil || len(reader.buf) != len(state) {
+		return &Error{
+			ColumnType: string(col.chType),
+			Err:        fmt.Errorf("invalid %s state of %d bytes", col.function, len(state)),
+		}
+	}
+	col.data = append(col.data, state...)
+	col.offsets = append(col.offsets, len(col.data))
+	return nil
+}
+
+func (col *AggregateFunction) Decode(reader *proto.Reader, rows int) error {
+	s := &stateReader{r: reader, buf: col.data}
+	for i := 0; i < rows; i++ {
+		if err := col.state.read(s); err != nil {
+			return &Error{
+				ColumnType: string(col.chType),
+				Err:        fmt.Errorf("failed to read state of row %d: %w", i, err),
+			}
+		}
+		col.offsets = append(col.offsets, len(s.buf))
+	}
+	col.data = s.buf
+	return nil
+}
+
+func (col *AggregateFunction) Encode(buffer *proto.Buffer) {
+	buffer.PutRaw(col.data)
+}
+
+// splitTypeParams splits type parameters on top level commas.
+func splitTypeParams(params string) []string {
+	var (
+		parts    []string
+		start    int
+		brackets int
+		quoted   bool
+	)
+	for i, r := range params {
+		switch {
+		case r == '\'' && (i == 0 || params[i-1] != '\\'):
+			quoted = !quoted
+		case quoted:
+		case r == '(':
+			brackets++
+		case r == ')':
+			brackets--
+		case r == ',' && brackets == 0:
+			parts = append(parts, strings.TrimSpace(params[start:i]))
+			start = i + 1
+		}
+	}
+	if last := strings.TrimSpace(params[start:]); len(last) != 0 {
+		parts = append(parts, last)
+	}
+	return parts
+}
+
+var _ Interface = (*AggregateFunction)(nil)
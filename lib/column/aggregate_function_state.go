@@ -0,0 +1,449 @@
+package column
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// stateReader reads a single serialized aggregate function state while keeping a copy of the raw bytes.
+type stateReader struct {
+	r interface {
+		io.Reader
+		io.ByteReader
+	}
+	buf []byte
+}
+
+func (s *stateReader) raw(n int) error {
+	start := len(s.buf)
+	s.buf = append(s.buf, make([]byte, n)...)
+	_, err := io.ReadFull(s.r, s.buf[start:])
+	return err
+}
+
+func (s *stateReader) uvarint() (uint64, error) {
+	var (
+		x     uint64
+		shift uint
+	)
+	for i := 0; i < binary.MaxVarintLen64; i++ {
+		b, err := s.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		s.buf = append(s.buf, b)
+		if b < 0x80 {
+			return x | uint64(b)<<shift, nil
+		}
+		x |= uint64(b&0x7f) << shift
+		shift += 7
+	}
+	return 0, fmt.Errorf("varint overflows a 64-bit integer")
+}
+
+func (s *stateReader) int32() (int32, error) {
+	if err := s.raw(4); err != nil {
+		return 0, err
+	}
+	return int32(binary.LittleEndian.Uint32(s.buf[len(s.buf)-4:])), nil
+}
+
+func (s *stateReader) bool() (bool, error) {
+	if err := s.raw(1); err != nil {
+		return false, err
+	}
+	return s.buf[len(s.buf)-1] != 0, nil
+}
+
+// aggregateState describes the binary format of the state of an aggregate function,
+// see src/AggregateFunctions in the ClickHouse repository.
+type aggregateState struct {
+	// read consumes exactly one state.
+	read func(s *stateReader) error
+	// decode converts a raw state into a Go value. nil when the state can only be passed through.
+	decode func(state []byte) (any, error)
+}
+
+// aggregateValueType is a fixed size value stored in aggregate function states.
+type aggregateValueType struct {
+	size   int
+	decode func([]byte) any
+}
+
+var aggregateValueTypes = map[string]aggregateValueType{
+	"Int8":    {1, func(b []byte) any { return int8(b[0]) }},
+	"Int16":   {2, func(b []byte) any { return int16(binary.LittleEndian.Uint16(b)) }},
+	"Int32":   {4, func(b []byte) any { return int32(binary.LittleEndian.Uint32(b)) }},
+	"Int64":   {8, func(b []byte) any { return int64(binary.LittleEndian.Uint64(b)) }},
+	"UInt8":   {1, func(b []byte) any { return b[0] }},
+	"UInt16":  {2, func(b []byte) any { return binary.LittleEndian.Uint16(b) }},
+	"UInt32":  {4, func(b []byte) any { return binary.LittleEndian.Uint32(b) }},
+	"UInt64":  {8, func(b []byte) any { return binary.LittleEndian.Uint64(b) }},
+	"Float32": {4, func(b []byte) any { return math.Float32frombits(binary.LittleEndian.Uint32(b)) }},
+	"Float64": {8, func(b []byte) any { return math.Float64frombits(binary.LittleEndian.Uint64(b)) }},
+	"Bool":    {1, func(b []byte) any { return b[0] != 0 }},
+	"Int128":  {16, nil},
+	"UInt128": {16, nil},
+	"Int256":  {32, nil},
+	"UInt256": {32, nil},
+	"Date":    {2, nil},
+	"Date32":  {4, nil},
+	"UUID":    {16, nil},
+	"IPv4":    {4, nil},
+	"IPv6":    {16, nil},
+}
+
+func aggregateValueTypeOf(t Type) (aggregateValueType, bool) {
+	switch s := string(t); {
+	case strings.HasPrefix(s, "DateTime64"):
+		return aggregateValueType{size: 8}, true
+	case strings.HasPrefix(s, "DateTime"):
+		return aggregateValueType{size: 4}, true
+	case strings.HasPrefix(s, "Enum8"):
+		return aggregateValueType{size: 1}, true
+	case strings.HasPrefix(s, "Enum16"):
+		return aggregateValueType{size: 2}, true
+	case strings.HasPrefix(s, "Decimal"):
+		size, ok := decimalSize(t)
+		return aggregateValueType{size: size}, ok
+	}
+	v, ok := aggregateValueTypes[string(t)]
+	return v, ok
+}
+
+func decimalSize(t Type) (int, bool) {
+	switch s := string(t); {
+	case strings.HasPrefix(s, "Decimal32"):
+		return 4, true
+	case strings.HasPrefix(s, "Decimal64"):
+		return 8, true
+	case strings.HasPrefix(s, "Decimal128"):
+		return 16, true
+	case strings.HasPrefix(s, "Decimal256"):
+		return 32, true
+	case strings.HasPrefix(s, "Decimal("):
+		params := strings.Split(t.params(), ",")
+		precision, err := strconv.Atoi(strings.TrimSpace(params[0]))
+		if err != nil {
+			return 0, false
+		}
+		switch {
+		case precision <= 9:
+			return 4, true
+		case precision <= 18:
+			return 8, true
+		case precision <= 38:
+			return 16, true
+		case precision <= 76:
+			return 32, true
+		}
+	}
+	return 0, false
+}
+
+// sumResultType returns the type accumulated by sum for values of type t.
+func sumResultType(t Type) (Type, bool) {
+	switch s := string(t); {
+	case s == "Int8", s == "Int16", s == "Int32", s == "Int64":
+		return "Int64", true
+	case s == "UInt8", s == "UInt16", s == "UInt32", s == "UInt64", s == "Bool":
+		return "UInt64", true
+	case s == "Float32", s == "Float64":
+		return "Float64", true
+	case s == "Int128", s == "UInt128", s == "Int256", s == "UInt256":
+		return t, true
+	case strings.HasPrefix(s, "Decimal"):
+		if size, ok := decimalSize(t); ok && size == 32 {
+			return "Decimal256(0)", true
+		}
+		return "Decimal128(0)", true
+	}
+	return "", false
+}
+
+func decodeFixed(v aggregateValueType) func([]byte) (any, error) {
+	if v.decode == nil {
+		return nil
+	}
+	return func(state []byte) (any, error) {
+		if len(state) != v.size {
+			return nil, fmt.Errorf("invalid state size %d, expected %d", len(state), v.size)
+		}
+		return v.decode(state), nil
+	}
+}
+
+func countState() aggregateState {
+	return aggregateState{
+		read: func(s *stateReader) error {
+			_, err := s.uvarint()
+			return err
+		},
+		decode: func(state []byte) (any, error) {
+			v, n := binary.Uvarint(state)
+			if n <= 0 {
+				return nil, fmt.Errorf("invalid count state")
+			}
+			return v, nil
+		},
+	}
+}
+
+func sumState(result Type) (aggregateState, bool) {
+	v, ok := aggregateValueTypeOf(result)
+	if !ok {
+		return aggregateState{}, false
+	}
+	return aggregateState{
+		read: func(s *stateReader) error {
+			return s.raw(v.size)
+		},
+		decode: decodeFixed(v),
+	}, true
+}
+
+// singleValueState is the state of any, anyLast, min and max: a flag followed by the value if set.
+func singleValueState(arg Type) (aggregateState, bool) {
+	if arg == "String" {
+		return aggregateState{
+			read: func(s *stateReader) error {
+				size, err := s.int32()
+				if err != nil || size <= 0 {
+					return err
+				}
+				return s.raw(int(size))
+			},
+			decode: func(state []byte) (any, error) {
+				if len(state) < 4 {
+					return nil, fmt.Errorf("invalid state size %d", len(state))
+				}
+				if size := int32(binary.LittleEndian.Uint32(state)); size < 0 {
+					return nil, nil
+				}
+				return strings.TrimSuffix(string(state[4:]), "\x00"), nil
+			},
+		}, true
+	}
+	v, ok := aggregateValueTypeOf(arg)
+	if !ok {
+		return aggregateState{}, false
+	}
+	state := aggregateState{
+		read: func(s *stateReader) error {
+			has, err := s.bool()
+			if err != nil || !has {
+				return err
+			}
+			return s.raw(v.size)
+		},
+	}
+	if v.decode != nil {
+		state.decode = func(state []byte) (any, error) {
+			if len(state) == 1 && state[0] == 0 {
+				return nil, nil
+			}
+			if len(state) != v.size+1 {
+				return nil, fmt.Errorf("invalid state size %d, expected %d", len(state), v.size+1)
+			}
+			return v.decode(state[1:]), nil
+		}
+	}
+	return state, true
+}
+
+// avgState is a numerator of the accumulated type followed by a VarUInt denominator.
+func avgState(arg Type) (aggregateState, bool) {
+	var numerator Type
+	switch arg {
+	case "Int8", "Int16", "Int32", "Int64", "UInt8", "UInt16", "UInt32", "UInt64", "Float32", "Float64":
+		numerator, _ = sumResultType(arg)
+	case "Int128", "UInt128", "Int256", "UInt256":
+		numerator = "Float64"
+	default:
+		return aggregateState{}, false
+	}
+	v := aggregateValueTypes[string(numerator)]
+	return aggregateState{
+		read: func(s *stateReader) error {
+			if err := s.raw(v.size); err != nil {
+				return err
+			}
+			_, err := s.uvarint()
+			return err
+		},
+		decode: func(state []byte) (any, error) {
+			if len(state) < v.size+1 {
+				return nil, fmt.Errorf("invalid state size %d", len(state))
+			}
+			denominator, n := binary.Uvarint(state[v.size:])
+			if n <= 0 {
+				return nil, fmt.Errorf("invalid avg state")
+			}
+			value := reflect.ValueOf(v.decode(state[:v.size]))
+			var sum float64
+			switch value.Kind() {
+			case reflect.Int64:
+				sum = float64(value.Int())
+			case reflect.Uint64:
+				sum = float64(value.Uint())
+			default:
+				sum = value.Float()
+			}
+			return sum / float64(denominator), nil
+		},
+	}, true
+}
+
+// uniqExactState is a hash set: the VarUInt size followed by the keys. Keys are the values themselves
+// for fixed size types and 128-bit hashes for anything else.
+func uniqExactState(args []Type) aggregateState {
+	size := 16
+	if len(args) == 1 {
+		if v, ok := aggregateValueTypeOf(args[0]); ok {
+			size = v.size
+		}
+	}
+	return aggregateState{
+		read: func(s *stateReader) error {
+			n, err := s.uvarint()
+			if err != nil {
+				return err
+			}
+			return s.raw(int(n) * size)
+		},
+		decode: func(state []byte) (any, error) {
+			n, l := binary.Uvarint(state)
+			if l <= 0 {
+				return nil, fmt.Errorf("invalid uniqExact state")
+			}
+			return n, nil
+		},
+	}
+}
+
+// uniqState is a UniquesHashSet: UInt8 skip degree, VarUInt size and the 32-bit hashes.
+// The cardinality estimate is computed by the server, so the state is passed through as is.
+func uniqState() aggregateState {
+	return aggregateState{
+		read: func(s *stateReader) error {
+			if err := s.raw(1); err != nil {
+				return err
+			}
+			n, err := s.uvarint()
+			if err != nil {
+				return err
+			}
+			return s.raw(int(n) * 4)
+		},
+	}
+}
+
+// groupArrayState is the VarUInt size followed by the values, strings are prefixed with their VarUInt length.
+func groupArrayState(arg Type) (aggregateState, bool) {
+	if arg == "String" {
+		return aggregateState{
+			read: func(s *stateReader) error {
+				n, err := s.uvarint()
+				if err != nil {
+					return err
+				}
+				for i := uint64(0); i < n; i++ {
+					l, err := s.uvarint()
+					if err != nil {
+						return err
+					}
+					if err := s.raw(int(l)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			decode: func(state []byte) (any, error) {
+				n, l := binary.Uvarint(state)
+				if l <= 0 {
+					return nil, fmt.Errorf("invalid groupArray state")
+				}
+				state = state[l:]
+				values := make([]string, 0, n)
+				for i := uint64(0); i < n; i++ {
+					size, l := binary.Uvarint(state)
+					if l <= 0 || uint64(len(state[l:])) < size {
+						return nil, fmt.Errorf("invalid groupArray state")
+					}
+					values = append(values, string(state[l:uint64(l)+size]))
+					state = state[uint64(l)+size:]
+				}
+				return values, nil
+			},
+		}, true
+	}
+	v, ok := aggregateValueTypeOf(arg)
+	if !ok {
+		return aggregateState{}, false
+	}
+	state := aggregateState{
+		read: func(s *stateReader) error {
+			n, err := s.uvarint()
+			if err != nil {
+				return err
+			}
+			return s.raw(int(n) * v.size)
+		},
+	}
+	if v.decode != nil {
+		state.decode = func(state []byte) (any, error) {
+			n, l := binary.Uvarint(state)
+			if l <= 0 || uint64(len(state[l:])) != n*uint64(v.size) {
+				return nil, fmt.Errorf("invalid groupArray state")
+			}
+			state = state[l:]
+			values := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(v.decode(make([]byte, v.size)))), 0, int(n))
+			for i := 0; i < int(n); i++ {
+				values = reflect.Append(values, reflect.ValueOf(v.decode(state[i*v.size:])))
+			}
+			return values.Interface(), nil
+		}
+	}
+	return state, true
+}
+
+// newAggregateState returns the state format of function for the given argument types.
+func newAggregateState(function string, args []Type) (aggregateState, bool) {
+	if name, ok := strings.CutSuffix(function, "If"); ok && len(args) > 0 {
+		// -If combinator keeps the state of the nested function, the last argument is the condition
+		function, args = name, args[:len(args)-1]
+	}
+	switch function {
+	case "count":
+		return countState(), true
+	case "uniqExact":
+		return uniqExactState(args), true
+	case "uniq":
+		return uniqState(), true
+	}
+	if len(args) != 1 {
+		return aggregateState{}, false
+	}
+	switch function {
+	case "sum":
+		result, ok := sumResultType(args[0])
+		if !ok {
+			return aggregateState{}, false
+		}
+		return sumState(result)
+	case "sumWithOverflow":
+		return sumState(args[0])
+	case "any", "anyLast", "min", "max":
+		return singleValueState(args[0])
+	case "avg":
+		return avgState(args[0])
+	case "groupArray":
+		return groupArrayState(args[0])
+	}
+	return aggregateState{}, false
+}
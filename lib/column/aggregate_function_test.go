@@ -0,0 +1,170 @@
+package column
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateFunction_parse(t *testing.T) {
+	cases := []struct {
+		typ      Type
+		function string
+		args     []Type
+	}{
+		{typ: "AggregateFunction(count)", function: "count"},
+		{typ: "AggregateFunction(uniq, String)", function: "uniq", args: []Type{"String"}},
+		{typ: "AggregateFunction(sumIf, UInt64, UInt8)", function: "sumIf", args: []Type{"UInt64", "UInt8"}},
+		{typ: "AggregateFunction(groupArray(10), Int16)", function: "groupArray", args: []Type{"Int16"}},
+		{typ: "AggregateFunction(uniqExact, Tuple(String, UInt8), UInt32)", function: "uniqExact", args: []Type{"Tuple(String, UInt8)", "UInt32"}},
+	}
+	for _, c := range cases {
+		col, err := c.typ.Column("agg", &ServerContext{})
+		require.NoError(t, err, c.typ)
+		agg := col.(*AggregateFunction)
+		assert.Equal(t, c.function, agg.Function())
+		assert.Equal(t, c.args, agg.args)
+	}
+
+	_, err := Type("AggregateFunction(quantilesTDigest(0.5), Float64)").Column("agg", &ServerContext{})
+	var unsupported *UnsupportedColumnTypeError
+	require.ErrorAs(t, err, &unsupported)
+}
+
+func TestAggregateFunction_UnsupportedFunction(t *testing.T) {
+	for typ, function := range map[Type]string{
+		"AggregateFunction(argMax, String, UInt64)":              "argMax",
+		"AggregateFunction(quantiles(0.5, 0.9), Float64)":        "quantiles",
+		"AggregateFunction(sumMap, Array(UInt8), Array(UInt64))": "sumMap",
+	} {
+		_, err := typ.Column("agg", &ServerContext{})
+		var unsupported *UnsupportedAggregateFunctionError
+		require.ErrorAs(t, err, &unsupported, typ)
+		assert.Equal(t, function, unsupported.Function)
+		assert.Contains(t, err.Error(), "column.Register")
+	}
+}
+
+func aggregateRoundTrip(t *testing.T, typ Type, states ...[]byte) *AggregateFunction {
+	var buffer proto.Buffer
+	for _, state := range states {
+		buffer.PutRaw(state)
+	}
+	col, err := typ.Column("agg", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.Decode(buffer.Reader(), len(states)))
+	require.Equal(t, len(states), col.Rows())
+	for i, state := range states {
+		var raw []byte
+		require.NoError(t, col.ScanRow(&raw, i))
+		assert.Equal(t, state, raw)
+	}
+
+	encoded := col.(*AggregateFunction)
+	var out proto.Buffer
+	encoded.Encode(&out)
+	assert.Equal(t, buffer.Buf[:len(out.Buf)], out.Buf)
+	return encoded
+}
+
+func TestAggregateFunction_Count(t *testing.T) {
+	col := aggregateRoundTrip(t, "AggregateFunction(count)", binary.AppendUvarint(nil, 300), binary.AppendUvarint(nil, 1))
+	var count uint64
+	require.NoError(t, col.ScanRow(&count, 0))
+	assert.Equal(t, uint64(300), count)
+}
+
+func TestAggregateFunction_Sum(t *testing.T) {
+	col := aggregateRoundTrip(t, "AggregateFunction(sum, Int32)", binary.LittleEndian.AppendUint64(nil, uint64(math.MaxUint64)))
+	var sum int64
+	require.NoError(t, col.ScanRow(&sum, 0))
+	assert.Equal(t, int64(-1), sum)
+}
+
+func TestAggregateFunction_SingleValue(t *testing.T) {
+	col := aggregateRoundTrip(t, "AggregateFunction(max, String)",
+		append(binary.LittleEndian.AppendUint32(nil, 6), "hello\x00"...),
+		binary.LittleEndian.AppendUint32(nil, math.MaxUint32),
+	)
+	var value *string
+	require.NoError(t, col.ScanRow(&value, 0))
+	require.NotNil(t, value)
+	assert.Equal(t, "hello", *value)
+	require.NoError(t, col.ScanRow(&value, 1))
+	assert.Nil(t, value)
+
+	col = aggregateRoundTrip(t, "AggregateFunction(anyLast, UInt16)", []byte{1, 7, 0}, []byte{0})
+	var v uint16
+	require.NoError(t, col.ScanRow(&v, 0))
+	assert.Equal(t, uint16(7), v)
+}
+
+func TestAggregateFunction_Avg(t *testing.T) {
+	state := binary.AppendUvarint(binary.LittleEndian.AppendUint64(nil, 10), 4)
+	col := aggregateRoundTrip(t, "AggregateFunction(avg, Int32)", state)
+	var avg float64
+	require.NoError(t, col.ScanRow(&avg, 0))
+	assert.Equal(t, 2.5, avg)
+
+	for _, typ := range []Type{"AggregateFunction(avg, IntervalDay)", "AggregateFunction(avg, Interval)", "AggregateFunction(avg, UInt512)"} {
+		_, err := typ.Column("agg", &ServerContext{})
+		var unsupported *UnsupportedColumnTypeError
+		assert.ErrorAs(t, err, &unsupported, typ)
+	}
+}
+
+func TestAggregateFunction_Uniq(t *testing.T) {
+	exact := binary.AppendUvarint(nil, 2)
+	exact = binary.LittleEndian.AppendUint64(exact, 1)
+	exact = binary.LittleEndian.AppendUint64(exact, 2)
+	col := aggregateRoundTrip(t, "AggregateFunction(uniqExact, UInt64)", exact)
+	var n uint64
+	require.NoError(t, col.ScanRow(&n, 0))
+	assert.Equal(t, uint64(2), n)
+
+	uniq := append([]byte{0}, binary.AppendUvarint(nil, 1)...)
+	uniq = binary.LittleEndian.AppendUint32(uniq, 42)
+	col = aggregateRoundTrip(t, "AggregateFunction(uniq, String)", uniq)
+	require.Error(t, col.ScanRow(&n, 0))
+}
+
+func TestAggregateFunction_GroupArray(t *testing.T) {
+	state := binary.AppendUvarint(nil, 2)
+	state = binary.AppendUvarint(state, 1)
+	state = append(state, 'a')
+	state = binary.AppendUvarint(state, 2)
+	state = append(state, "bc"...)
+	col := aggregateRoundTrip(t, "AggregateFunction(groupArray, String)", state)
+	var values []string
+	require.NoError(t, col.ScanRow(&values, 0))
+	assert.Equal(t, []string{"a", "bc"}, values)
+
+	numbers := binary.AppendUvarint(nil, 2)
+	numbers = binary.LittleEndian.AppendUint16(numbers, 5)
+	numbers = binary.LittleEndian.AppendUint16(numbers, 6)
+	col = aggregateRoundTrip(t, "AggregateFunction(groupArray, Int16)", numbers)
+	var ints []int16
+	require.NoError(t, col.ScanRow(&ints, 0))
+	assert.Equal(t, []int16{5, 6}, ints)
+}
+
+func TestAggregateFunction_AppendRow(t *testing.T) {
+	col, err := Type("AggregateFunction(count)").Column("agg", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow(binary.AppendUvarint(nil, 5)))
+	_, err = col.Append([][]byte{binary.AppendUvarint(nil, 6)})
+	require.NoError(t, err)
+	assert.Equal(t, 2, col.Rows())
+
+	// trailing bytes would corrupt the following states
+	require.Error(t, col.AppendRow([]byte{1, 2}))
+	require.Error(t, col.AppendRow([]byte{}))
+	assert.Equal(t, 2, col.Rows())
+
+	col.Reset()
+	assert.Equal(t, 0, col.Rows())
+}
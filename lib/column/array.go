@@ -118,6 +118,13 @@ func (col *Array) Append(v any) (nulls []uint8, err error) {
 }
 
 func (col *Array) AppendRow(v any) error {
+	if marshaler, ok := v.(ColumnMarshaler); ok {
+		val, err := marshalColumn(col, "AppendRow", marshaler)
+		if err != nil {
+			return err
+		}
+		return col.AppendRow(val)
+	}
 	if col.depth == 1 {
 		// try to use reflection-free method.
 		return col.appendRowPlain(v)
@@ -253,6 +260,13 @@ func (col *Array) WriteStatePrefix(buffer *proto.Buffer) error {
 }
 
 func (col *Array) ScanRow(dest any, row int) error {
+	if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+		value, err := col.scan(scanTypeAny, row)
+		if err != nil {
+			return err
+		}
+		return unmarshaler.UnmarshalColumn(value.Interface())
+	}
 	if scanner, ok := dest.(sql.Scanner); ok {
 		value, err := col.scan(scanTypeAny, row)
 		if err != nil {
@@ -379,6 +393,19 @@ func (col *Array) scanSliceOfObjects(sliceType reflect.Type, row int) (reflect.V
 			return col.scanSliceOfStructs(sliceType, row)
 		case reflect.Map:
 			return col.scanSliceOfMaps(sliceType, row)
+		case reflect.Pointer:
+			if sliceType.Elem().Elem().Kind() != reflect.Struct {
+				break
+			}
+			structs, err := col.scanSliceOfStructs(reflect.SliceOf(sliceType.Elem().Elem()), row)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			rSlice := reflect.MakeSlice(sliceType, structs.Len(), structs.Len())
+			for i := 0; i < structs.Len(); i++ {
+				rSlice.Index(i).Set(structs.Index(i).Addr())
+			}
+			return rSlice, nil
 		case reflect.Slice:
 			// tuples can be read as arrays
 			return col.scanSlice(sliceType, row, 0)
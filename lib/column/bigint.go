@@ -53,6 +53,9 @@ func (col *BigInt) ScanRow(dest any, row int) error {
 		*d = new(big.Int)
 		**d = *col.row(row)
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(col.Row(row, false))
+		}
 		return &ColumnConverterError{
 			Op:   "ScanRow",
 			To:   fmt.Sprintf("%T", dest),
@@ -81,6 +84,9 @@ func (col *BigInt) Append(v any) (nulls []uint8, err error) {
 			}
 		}
 	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -116,6 +122,13 @@ func (col *BigInt) AppendRow(v any) error {
 	case nil:
 		col.append(big.NewInt(0))
 	default:
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -48,6 +48,8 @@ func (col *Bool) ScanRow(dest any, row int) error {
 	case **bool:
 		*d = new(bool)
 		**d = col.row(row)
+	case ColumnUnmarshaler:
+		return d.UnmarshalColumn(col.Row(row, false))
 	case sql.Scanner:
 		return d.Scan(col.row(row))
 	default:
@@ -94,6 +96,9 @@ func (col *Bool) Append(v any) (nulls []uint8, err error) {
 			col.Append(v[i])
 		}
 	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -136,6 +141,13 @@ func (col *Bool) AppendRow(v any) error {
 		}
 	case nil:
 	default:
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -21,6 +21,10 @@ import (
 )
 
 func (t Type) Column(name string, sc *ServerContext) (Interface, error) {
+	if factory, ok := lookupFactory(t); ok {
+		return registeredColumn(factory, t, name, sc)
+	}
+
 	switch t {
 {{- range . }}
 	case "{{ .ChType }}":
@@ -104,6 +108,8 @@ func (t Type) Column(name string, sc *ServerContext) (Interface, error) {
         }, nil
 	case "Point":
 		return &Point{name: name}, nil
+	case "Geometry":
+		return (&Geometry{name: name}).parse(sc)
 	case "LineString":
 		set, err := (&Array{name: name}).parse("Array(Point)", sc)
 		if err != nil {
@@ -125,7 +131,7 @@ func (t Type) Column(name string, sc *ServerContext) (Interface, error) {
 			name: name,
 		}, nil
 	case "String":
-		return &String{name: name, col: colStrProvider(name)}, nil
+		return &String{name: name, col: colStrProvider(name), zeroCopy: sc.zeroCopy()}, nil
 	case "SharedVariant":
 		return &SharedVariant{name: name}, nil
 	case "Time":
@@ -147,6 +153,8 @@ func (t Type) Column(name string, sc *ServerContext) (Interface, error) {
 		return (&Decimal{name: name}).parse(t)
 	case strings.HasPrefix(strType, "Nested("):
 		return (&Nested{name: name}).parse(t, sc)
+	case strings.HasPrefix(string(t), "QBit("):
+		return (&QBit{name: name}).parse(t)
 	case strings.HasPrefix(string(t), "Array("):
 		return (&Array{name: name}).parse(t, sc)
 	case strings.HasPrefix(string(t), "Interval"):
@@ -154,9 +162,11 @@ func (t Type) Column(name string, sc *ServerContext) (Interface, error) {
 	case strings.HasPrefix(string(t), "Nullable"):
 		return (&Nullable{name: name}).parse(t, sc)
 	case strings.HasPrefix(string(t), "FixedString"):
-		return (&FixedString{name: name}).parse(t)
+		return (&FixedString{name: name, zeroCopy: sc.zeroCopy()}).parse(t)
 	case strings.HasPrefix(string(t), "LowCardinality"):
 		return (&LowCardinality{name: name}).parse(t, sc)
+	case strings.HasPrefix(string(t), "AggregateFunction("):
+		return (&AggregateFunction{name: name}).parse(t)
 	case strings.HasPrefix(string(t), "SimpleAggregateFunction"):
 		return (&SimpleAggregateFunction{name: name}).parse(t, sc)
 	case strings.HasPrefix(string(t), "Enum8") || strings.HasPrefix(string(t), "Enum16"):
@@ -199,6 +209,7 @@ var (
 		scanTypeByte    = reflect.TypeOf([]byte{})
 		scanTypeUUID    = reflect.TypeOf(uuid.UUID{})
 		scanTypeTime    = reflect.TypeOf(time.Time{})
+		scanTypeDuration = reflect.TypeOf(time.Duration(0))
 		scanTypeRing    = reflect.TypeOf(orb.Ring{})
 		scanTypePoint   = reflect.TypeOf(orb.Point{})
 		scanTypeSlice   = reflect.TypeOf([]any{})
@@ -210,6 +221,7 @@ var (
 		scanTypeMultiPolygon = reflect.TypeOf(orb.MultiPolygon{})
 		scanTypeLineString = reflect.TypeOf(orb.LineString{})
 		scanTypeMultiLineString = reflect.TypeOf(orb.MultiLineString{})
+		scanTypeGeometry = reflect.TypeOf((*orb.Geometry)(nil)).Elem()
 		scanTypeVariant = reflect.TypeOf(chcol.Variant{})
 		scanTypeDynamic = reflect.TypeOf(chcol.Dynamic{})
 		scanTypeJSON    = reflect.TypeOf(chcol.JSON{})
@@ -264,6 +276,9 @@ func (col *{{ .ChType }}) ScanRow(dest any, row int) error {
 		}
     {{- end }}
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(value)
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(value)
 		}
@@ -342,6 +357,10 @@ func (col *{{ .ChType }}) Append(v any) (nulls []uint8,err error) {
 	{{- end }}
 	default:
 
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+
 	    if valuer, ok := v.(driver.Valuer); ok {
             val, err := valuer.Value()
             if err != nil {
@@ -423,6 +442,14 @@ func (col *{{ .ChType }}) AppendRow(v any) error {
 	{{- end }}
 	default:
 
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 	    if valuer, ok := v.(driver.Valuer); ok {
             val, err := valuer.Value()
             if err != nil {
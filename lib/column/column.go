@@ -16,6 +16,15 @@ var escapeColRegex = regexp.MustCompile("^[a-zA-Z_][0-9a-zA-Z_]*$")
 var colEscape = strings.NewReplacer("`", "\\`", "\\", "\\\\")
 var colUnEscape = strings.NewReplacer("\\`", "`", "\\\\", "\\")
 
+// QuoteIdentifier returns name as an SQL identifier, wrapped in backquotes unless it only
+// consists of letters, digits and underscores and does not start with a digit.
+func QuoteIdentifier(name string) string {
+	if !escapeColRegex.MatchString(name) {
+		return fmt.Sprintf("`%s`", colEscape.Replace(name))
+	}
+	return name
+}
+
 type Type string
 
 func (t Type) params() string {
@@ -83,4 +92,12 @@ type ServerContext struct {
 	VersionMinor uint64
 	VersionPatch uint64
 	Timezone     *time.Location
+	// ZeroCopy makes String and FixedString columns scan into views of the block buffer instead of copies.
+	// It is set by the connection from Options.ZeroCopyStrings or the WithZeroCopyStrings query option
+	// for every query and should not be set directly.
+	ZeroCopy bool
+}
+
+func (sc *ServerContext) zeroCopy() bool {
+	return sc != nil && sc.ZeroCopy
 }
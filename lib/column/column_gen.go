@@ -20,6 +20,10 @@ import (
 )
 
 func (t Type) Column(name string, sc *ServerContext) (Interface, error) {
+	if factory, ok := lookupFactory(t); ok {
+		return registeredColumn(factory, t, name, sc)
+	}
+
 	switch t {
 	case "BFloat16":
 		return &BFloat16{name: name}, nil
@@ -121,6 +125,8 @@ func (t Type) Column(name string, sc *ServerContext) (Interface, error) {
 		}, nil
 	case "Point":
 		return &Point{name: name}, nil
+	case "Geometry":
+		return (&Geometry{name: name}).parse(sc)
 	case "LineString":
 		set, err := (&Array{name: name}).parse("Array(Point)", sc)
 		if err != nil {
@@ -142,7 +148,7 @@ func (t Type) Column(name string, sc *ServerContext) (Interface, error) {
 			name: name,
 		}, nil
 	case "String":
-		return &String{name: name, col: colStrProvider(name)}, nil
+		return &String{name: name, col: colStrProvider(name), zeroCopy: sc.zeroCopy()}, nil
 	case "SharedVariant":
 		return &SharedVariant{name: name}, nil
 	case "Time":
@@ -173,9 +179,11 @@ func (t Type) Column(name string, sc *ServerContext) (Interface, error) {
 	case strings.HasPrefix(string(t), "Nullable"):
 		return (&Nullable{name: name}).parse(t, sc)
 	case strings.HasPrefix(string(t), "FixedString"):
-		return (&FixedString{name: name}).parse(t)
+		return (&FixedString{name: name, zeroCopy: sc.zeroCopy()}).parse(t)
 	case strings.HasPrefix(string(t), "LowCardinality"):
 		return (&LowCardinality{name: name}).parse(t, sc)
+	case strings.HasPrefix(string(t), "AggregateFunction("):
+		return (&AggregateFunction{name: name}).parse(t)
 	case strings.HasPrefix(string(t), "SimpleAggregateFunction"):
 		return (&SimpleAggregateFunction{name: name}).parse(t, sc)
 	case strings.HasPrefix(string(t), "Enum8") || strings.HasPrefix(string(t), "Enum16"):
@@ -284,6 +292,7 @@ var (
 	scanTypeMultiPolygon    = reflect.TypeOf(orb.MultiPolygon{})
 	scanTypeLineString      = reflect.TypeOf(orb.LineString{})
 	scanTypeMultiLineString = reflect.TypeOf(orb.MultiLineString{})
+	scanTypeGeometry        = reflect.TypeOf((*orb.Geometry)(nil)).Elem()
 	scanTypeVariant         = reflect.TypeOf(chcol.Variant{})
 	scanTypeDynamic         = reflect.TypeOf(chcol.Dynamic{})
 	scanTypeJSON            = reflect.TypeOf(chcol.JSON{})
@@ -319,6 +328,9 @@ func (col *BFloat16) ScanRow(dest any, row int) error {
 		*d = new(float32)
 		**d = value
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(value)
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(value)
 		}
@@ -360,6 +372,10 @@ func (col *BFloat16) Append(v any) (nulls []uint8, err error) {
 		}
 	default:
 
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -397,6 +413,14 @@ func (col *BFloat16) AppendRow(v any) error {
 		col.col.Append(0)
 	default:
 
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -460,6 +484,9 @@ func (col *Float32) ScanRow(dest any, row int) error {
 		*d = new(float32)
 		**d = value
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(value)
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(value)
 		}
@@ -501,6 +528,10 @@ func (col *Float32) Append(v any) (nulls []uint8, err error) {
 		}
 	default:
 
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -538,6 +569,14 @@ func (col *Float32) AppendRow(v any) error {
 		col.col.Append(0)
 	default:
 
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -603,6 +642,9 @@ func (col *Float64) ScanRow(dest any, row int) error {
 	case *sql.NullFloat64:
 		return d.Scan(value)
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(value)
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(value)
 		}
@@ -657,6 +699,10 @@ func (col *Float64) Append(v any) (nulls []uint8, err error) {
 		}
 	default:
 
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -708,6 +754,14 @@ func (col *Float64) AppendRow(v any) error {
 		}
 	default:
 
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -778,6 +832,9 @@ func (col *Int8) ScanRow(dest any, row int) error {
 			*d = true
 		}
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(value)
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(value)
 		}
@@ -839,6 +896,10 @@ func (col *Int8) Append(v any) (nulls []uint8, err error) {
 		}
 	default:
 
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -888,6 +949,14 @@ func (col *Int8) AppendRow(v any) error {
 		col.col.Append(val)
 	default:
 
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -953,6 +1022,9 @@ func (col *Int16) ScanRow(dest any, row int) error {
 	case *sql.NullInt16:
 		return d.Scan(value)
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(value)
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(value)
 		}
@@ -1007,6 +1079,10 @@ func (col *Int16) Append(v any) (nulls []uint8, err error) {
 		}
 	default:
 
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1058,6 +1134,14 @@ func (col *Int16) AppendRow(v any) error {
 		}
 	default:
 
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1123,6 +1207,9 @@ func (col *Int32) ScanRow(dest any, row int) error {
 	case *sql.NullInt32:
 		return d.Scan(value)
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(value)
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(value)
 		}
@@ -1177,6 +1264,10 @@ func (col *Int32) Append(v any) (nulls []uint8, err error) {
 		}
 	default:
 
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1228,6 +1319,14 @@ func (col *Int32) AppendRow(v any) error {
 		}
 	default:
 
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1295,6 +1394,9 @@ func (col *Int64) ScanRow(dest any, row int) error {
 	case *sql.NullInt64:
 		return d.Scan(value)
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(value)
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(value)
 		}
@@ -1349,6 +1451,10 @@ func (col *Int64) Append(v any) (nulls []uint8, err error) {
 		}
 	default:
 
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1404,6 +1510,14 @@ func (col *Int64) AppendRow(v any) error {
 		col.col.Append(int64(*v))
 	default:
 
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1474,6 +1588,9 @@ func (col *UInt8) ScanRow(dest any, row int) error {
 			*d = true
 		}
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(value)
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(value)
 		}
@@ -1515,6 +1632,10 @@ func (col *UInt8) Append(v any) (nulls []uint8, err error) {
 		}
 	default:
 
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1558,6 +1679,14 @@ func (col *UInt8) AppendRow(v any) error {
 		col.col.Append(t)
 	default:
 
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1621,6 +1750,9 @@ func (col *UInt16) ScanRow(dest any, row int) error {
 		*d = new(uint16)
 		**d = value
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(value)
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(value)
 		}
@@ -1662,6 +1794,10 @@ func (col *UInt16) Append(v any) (nulls []uint8, err error) {
 		}
 	default:
 
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1699,6 +1835,14 @@ func (col *UInt16) AppendRow(v any) error {
 		col.col.Append(0)
 	default:
 
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1762,6 +1906,9 @@ func (col *UInt32) ScanRow(dest any, row int) error {
 		*d = new(uint32)
 		**d = value
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(value)
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(value)
 		}
@@ -1803,6 +1950,10 @@ func (col *UInt32) Append(v any) (nulls []uint8, err error) {
 		}
 	default:
 
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1840,6 +1991,14 @@ func (col *UInt32) AppendRow(v any) error {
 		col.col.Append(0)
 	default:
 
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1903,6 +2062,9 @@ func (col *UInt64) ScanRow(dest any, row int) error {
 		*d = new(uint64)
 		**d = value
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(value)
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(value)
 		}
@@ -1944,6 +2106,10 @@ func (col *UInt64) Append(v any) (nulls []uint8, err error) {
 		}
 	default:
 
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1981,6 +2147,14 @@ func (col *UInt64) AppendRow(v any) error {
 		col.col.Append(0)
 	default:
 
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
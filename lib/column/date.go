@@ -69,6 +69,9 @@ func (col *Date) ScanRow(dest any, row int) error {
 	case *sql.NullTime:
 		return d.Scan(col.row(row))
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(col.Row(row, false))
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(col.row(row))
 		}
@@ -135,6 +138,9 @@ func (col *Date) Append(v any) (nulls []uint8, err error) {
 			}
 		}
 	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -200,6 +206,13 @@ func (col *Date) AppendRow(v any) error {
 			col.col.Append(datetime)
 		}
 	default:
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -86,6 +86,9 @@ func (col *DateTime) ScanRow(dest any, row int) error {
 	case *sql.NullTime:
 		return d.Scan(col.row(row))
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(col.Row(row, false))
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(col.row(row))
 		}
@@ -172,6 +175,9 @@ func (col *DateTime) Append(v any) (nulls []uint8, err error) {
 			}
 		}
 	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -247,6 +253,13 @@ func (col *DateTime) AppendRow(v any) error {
 			col.col.Append(dateTime)
 		}
 	default:
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
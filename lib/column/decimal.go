@@ -3,9 +3,11 @@ package column
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"reflect"
 	"strconv"
@@ -13,6 +15,7 @@ import (
 
 	"github.com/ClickHouse/ch-go/proto"
 
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
 	"github.com/shopspring/decimal"
 )
 
@@ -94,13 +97,25 @@ func (col *Decimal) row(i int) *decimal.Decimal {
 	case *proto.ColDecimal64:
 		v := vCol.Row(i)
 		value = decimal.New(int64(v), int32(-col.scale))
+	default:
+		value = decimal.NewFromBigInt(col.rowBigInt(i), int32(-col.scale))
+	}
+	return &value
+}
+
+// rowBigInt returns the unscaled value at row i.
+func (col *Decimal) rowBigInt(i int) *big.Int {
+	switch vCol := col.col.(type) {
+	case *proto.ColDecimal32:
+		return big.NewInt(int64(vCol.Row(i)))
+	case *proto.ColDecimal64:
+		return big.NewInt(int64(vCol.Row(i)))
 	case *proto.ColDecimal128:
 		v := vCol.Row(i)
 		b := make([]byte, 16)
 		binary.LittleEndian.PutUint64(b[0:64/8], v.Low)
 		binary.LittleEndian.PutUint64(b[64/8:128/8], v.High)
-		bv := rawToBigInt(b, true)
-		value = decimal.NewFromBigInt(bv, int32(-col.scale))
+		return rawToBigInt(b, true)
 	case *proto.ColDecimal256:
 		v := vCol.Row(i)
 		b := make([]byte, 32)
@@ -108,10 +123,60 @@ func (col *Decimal) row(i int) *decimal.Decimal {
 		binary.LittleEndian.PutUint64(b[64/8:128/8], v.Low.High)
 		binary.LittleEndian.PutUint64(b[128/8:192/8], v.High.Low)
 		binary.LittleEndian.PutUint64(b[192/8:256/8], v.High.High)
-		bv := rawToBigInt(b, true)
-		value = decimal.NewFromBigInt(bv, int32(-col.scale))
+		return rawToBigInt(b, true)
 	}
-	return &value
+	return new(big.Int)
+}
+
+// rowRat returns the value at row i as an exact fraction.
+func (col *Decimal) rowRat(i int) *big.Rat {
+	return new(big.Rat).SetFrac(col.rowBigInt(i), pow10BigInt(col.scale))
+}
+
+// rowInt128 returns the unscaled value at row i, ok is false if it doesn't fit into 128 bits.
+func (col *Decimal) rowInt128(i int) (_ proto.Int128, ok bool) {
+	switch vCol := col.col.(type) {
+	case *proto.ColDecimal32:
+		return int64ToInt128(int64(vCol.Row(i))), true
+	case *proto.ColDecimal64:
+		return int64ToInt128(int64(vCol.Row(i))), true
+	case *proto.ColDecimal128:
+		return proto.Int128(vCol.Row(i)), true
+	case *proto.ColDecimal256:
+		v := vCol.Row(i)
+		sign := uint64(int64(v.Low.High) >> 63)
+		if v.High.Low != sign || v.High.High != sign {
+			return proto.Int128{}, false
+		}
+		return proto.Int128{Low: v.Low.Low, High: v.Low.High}, true
+	}
+	return proto.Int128{}, false
+}
+
+func (col *Decimal) rowDecimal64(dest any, i int) (chcol.Decimal64, error) {
+	v, ok := col.rowInt128(i)
+	if !ok || int64(v.High) != int64(v.Low)>>63 {
+		return chcol.Decimal64{}, &ColumnConverterError{
+			Op:   "ScanRow",
+			To:   fmt.Sprintf("%T", dest),
+			From: string(col.chType),
+			Hint: "value overflows int64, try using *chcol.Decimal128",
+		}
+	}
+	return chcol.Decimal64{Value: int64(v.Low), Scale: uint8(col.scale)}, nil
+}
+
+func (col *Decimal) rowDecimal128(dest any, i int) (chcol.Decimal128, error) {
+	v, ok := col.rowInt128(i)
+	if !ok {
+		return chcol.Decimal128{}, &ColumnConverterError{
+			Op:   "ScanRow",
+			To:   fmt.Sprintf("%T", dest),
+			From: string(col.chType),
+			Hint: "value overflows Int128, try using *big.Rat",
+		}
+	}
+	return chcol.Decimal128{Value: v, Scale: uint8(col.scale)}, nil
 }
 
 func (col *Decimal) ScanRow(dest any, row int) error {
@@ -121,10 +186,49 @@ func (col *Decimal) ScanRow(dest any, row int) error {
 	case **decimal.Decimal:
 		*d = new(decimal.Decimal)
 		**d = *col.row(row)
+	case *big.Rat:
+		d.Set(col.rowRat(row))
+	case **big.Rat:
+		*d = col.rowRat(row)
+	case *big.Float:
+		d.SetRat(col.rowRat(row))
+	case **big.Float:
+		*d = new(big.Float).SetRat(col.rowRat(row))
+	case *chcol.Decimal64:
+		v, err := col.rowDecimal64(dest, row)
+		if err != nil {
+			return err
+		}
+		*d = v
+	case **chcol.Decimal64:
+		v, err := col.rowDecimal64(dest, row)
+		if err != nil {
+			return err
+		}
+		*d = &v
+	case *chcol.Decimal128:
+		v, err := col.rowDecimal128(dest, row)
+		if err != nil {
+			return err
+		}
+		*d = v
+	case **chcol.Decimal128:
+		v, err := col.rowDecimal128(dest, row)
+		if err != nil {
+			return err
+		}
+		*d = &v
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(col.Row(row, false))
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(*col.row(row))
 		}
+		// other decimal libraries such as apd.Decimal without a Scan method are supported through their text representation
+		if text, ok := dest.(encoding.TextUnmarshaler); ok {
+			return text.UnmarshalText([]byte(col.row(row).String()))
+		}
 		return &ColumnConverterError{
 			Op:   "ScanRow",
 			To:   fmt.Sprintf("%T", dest),
@@ -179,7 +283,18 @@ func (col *Decimal) Append(v any) (nulls []uint8, err error) {
 			}
 			col.append(&d)
 		}
+	case []*big.Rat, []*big.Float, []chcol.Decimal64, []chcol.Decimal128:
+		values := reflect.ValueOf(v)
+		nulls = make([]uint8, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			if err := col.AppendRow(values.Index(i).Interface()); err != nil {
+				return nil, err
+			}
+		}
 	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -224,8 +339,46 @@ func (col *Decimal) AppendRow(v any) error {
 			}
 			value = d
 		}
+	case chcol.Decimal64:
+		return col.appendDecimal64(v)
+	case *chcol.Decimal64:
+		if v != nil {
+			return col.appendDecimal64(*v)
+		}
+	case chcol.Decimal128:
+		return col.appendDecimal128(v)
+	case *chcol.Decimal128:
+		if v != nil {
+			return col.appendDecimal128(*v)
+		}
+	case *big.Rat:
+		if v != nil {
+			col.appendRat(v)
+			return nil
+		}
+	case *big.Float:
+		if v != nil {
+			if v.IsInf() {
+				return &ColumnConverterError{
+					Op:   "AppendRow",
+					To:   string(col.chType),
+					From: "*big.Float",
+					Hint: "infinite values can't be stored",
+				}
+			}
+			r, _ := v.Rat(nil)
+			col.appendRat(r)
+			return nil
+		}
 	case nil:
 	default:
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -238,6 +391,18 @@ func (col *Decimal) AppendRow(v any) error {
 			}
 			return col.AppendRow(val)
 		}
+		if text, ok := v.(encoding.TextMarshaler); ok {
+			val, err := text.MarshalText()
+			if err != nil {
+				return &ColumnConverterError{
+					Op:   "AppendRow",
+					To:   string(col.chType),
+					From: fmt.Sprintf("%T", v),
+					Hint: "could not get encoding.TextMarshaler value",
+				}
+			}
+			return col.AppendRow(string(val))
+		}
 		return &ColumnConverterError{
 			Op:   "AppendRow",
 			To:   string(col.chType),
@@ -285,6 +450,76 @@ func (col *Decimal) append(v *decimal.Decimal) {
 	}
 }
 
+// appendRat appends v truncated to the scale of the column.
+func (col *Decimal) appendRat(v *big.Rat) {
+	unscaled := new(big.Int).Mul(v.Num(), pow10BigInt(col.scale))
+	unscaled.Quo(unscaled, v.Denom())
+	value := decimal.NewFromBigInt(unscaled, int32(-col.scale))
+	col.append(&value)
+}
+
+func (col *Decimal) appendDecimal64(v chcol.Decimal64) error {
+	if int(v.Scale) != col.scale {
+		value := decimal.New(v.Value, -int32(v.Scale))
+		col.append(&value)
+		return nil
+	}
+	return col.appendInt128(int64ToInt128(v.Value))
+}
+
+func (col *Decimal) appendDecimal128(v chcol.Decimal128) error {
+	if int(v.Scale) != col.scale {
+		b := make([]byte, 16)
+		binary.LittleEndian.PutUint64(b[0:64/8], v.Value.Low)
+		binary.LittleEndian.PutUint64(b[64/8:128/8], v.Value.High)
+		value := decimal.NewFromBigInt(rawToBigInt(b, true), -int32(v.Scale))
+		col.append(&value)
+		return nil
+	}
+	return col.appendInt128(v.Value)
+}
+
+// appendInt128 appends an unscaled value that already has the scale of the column.
+func (col *Decimal) appendInt128(v proto.Int128) error {
+	fitsInt64 := int64(v.High) == int64(v.Low)>>63
+	switch vCol := col.col.(type) {
+	case *proto.ColDecimal32:
+		if !fitsInt64 || int64(v.Low) < math.MinInt32 || int64(v.Low) > math.MaxInt32 {
+			return col.overflowError()
+		}
+		vCol.Append(proto.Decimal32(int64(v.Low)))
+	case *proto.ColDecimal64:
+		if !fitsInt64 {
+			return col.overflowError()
+		}
+		vCol.Append(proto.Decimal64(int64(v.Low)))
+	case *proto.ColDecimal128:
+		vCol.Append(proto.Decimal128(v))
+	case *proto.ColDecimal256:
+		sign := uint64(int64(v.High) >> 63)
+		vCol.Append(proto.Decimal256{
+			Low:  proto.UInt128{Low: v.Low, High: v.High},
+			High: proto.UInt128{Low: sign, High: sign},
+		})
+	}
+	return nil
+}
+
+func (col *Decimal) overflowError() error {
+	return &Error{
+		ColumnType: string(col.chType),
+		Err:        errors.New("value overflows the column type"),
+	}
+}
+
+func int64ToInt128(v int64) proto.Int128 {
+	return proto.Int128{Low: uint64(v), High: uint64(v >> 63)}
+}
+
+func pow10BigInt(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
 func (col *Decimal) Decode(reader *proto.Reader, rows int) error {
 	return col.col.DecodeColumn(reader, rows)
 }
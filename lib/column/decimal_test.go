@@ -0,0 +1,130 @@
+package column
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
+)
+
+// testTextDecimal stands in for decimal libraries like apd.Decimal which implement encoding.TextMarshaler
+type testTextDecimal struct {
+	text string
+}
+
+func (d testTextDecimal) MarshalText() ([]byte, error) {
+	return []byte(d.text), nil
+}
+
+func (d *testTextDecimal) UnmarshalText(b []byte) error {
+	d.text = string(b)
+	return nil
+}
+
+func TestDecimal_BigRatAndFloat(t *testing.T) {
+	for _, chType := range []Type{"Decimal(9, 3)", "Decimal(18, 3)", "Decimal(38, 3)", "Decimal(76, 3)"} {
+		t.Run(string(chType), func(t *testing.T) {
+			col, err := chType.Column("d", &ServerContext{})
+			require.NoError(t, err)
+			require.NoError(t, col.AppendRow(big.NewRat(-1, 8)))
+			require.NoError(t, col.AppendRow(big.NewFloat(2.5)))
+			// truncated to the scale of the column like other values
+			require.NoError(t, col.AppendRow(big.NewRat(1, 3)))
+
+			var r big.Rat
+			require.NoError(t, col.ScanRow(&r, 0))
+			assert.Equal(t, "-1/8", r.String())
+			var f *big.Float
+			require.NoError(t, col.ScanRow(&f, 1))
+			assert.Equal(t, "2.5", f.Text('f', -1))
+			require.NoError(t, col.ScanRow(&r, 2))
+			assert.Equal(t, "333/1000", r.String())
+		})
+	}
+}
+
+func TestDecimal_Scaled(t *testing.T) {
+	for _, chType := range []Type{"Decimal(9, 2)", "Decimal(18, 2)", "Decimal(38, 2)", "Decimal(76, 2)"} {
+		t.Run(string(chType), func(t *testing.T) {
+			col, err := chType.Column("d", &ServerContext{})
+			require.NoError(t, err)
+			require.NoError(t, col.AppendRow(chcol.Decimal64{Value: -12345, Scale: 2}))
+			require.NoError(t, col.AppendRow(chcol.Decimal64{Value: 15, Scale: 1}))
+			require.NoError(t, col.AppendRow(chcol.Decimal128{Value: proto.Int128{Low: 100}, Scale: 2}))
+
+			var v chcol.Decimal64
+			require.NoError(t, col.ScanRow(&v, 0))
+			assert.Equal(t, chcol.Decimal64{Value: -12345, Scale: 2}, v)
+			require.NoError(t, col.ScanRow(&v, 1))
+			assert.Equal(t, chcol.Decimal64{Value: 150, Scale: 2}, v)
+
+			var v128 chcol.Decimal128
+			require.NoError(t, col.ScanRow(&v128, 0))
+			assert.Equal(t, chcol.Decimal128{Value: proto.Int128{Low: uint64(0xffffffffffffcfc7), High: ^uint64(0)}, Scale: 2}, v128)
+			require.NoError(t, col.ScanRow(&v128, 2))
+			assert.Equal(t, chcol.Decimal128{Value: proto.Int128{Low: 100}, Scale: 2}, v128)
+
+			assert.Equal(t, "-123.45", col.Row(0, false).(decimal.Decimal).String())
+		})
+	}
+}
+
+func TestDecimal_ScaledOverflow(t *testing.T) {
+	col, err := Type("Decimal(9, 0)").Column("d", &ServerContext{})
+	require.NoError(t, err)
+	var colErr *Error
+	require.ErrorAs(t, col.AppendRow(chcol.Decimal64{Value: 1 << 40}), &colErr)
+
+	col, err = Type("Decimal(38, 0)").Column("d", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow(chcol.Decimal128{Value: proto.Int128{High: 1}}))
+	var v chcol.Decimal64
+	var converterErr *ColumnConverterError
+	require.ErrorAs(t, col.ScanRow(&v, 0), &converterErr)
+}
+
+func TestDecimal_TextMarshaler(t *testing.T) {
+	col, err := Type("Decimal(18, 4)").Column("d", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow(testTextDecimal{text: "-3.1415"}))
+	_, err = col.Append([]*big.Rat{big.NewRat(1, 2)})
+	require.NoError(t, err)
+
+	var d testTextDecimal
+	require.NoError(t, col.ScanRow(&d, 0))
+	assert.Equal(t, "-3.1415", d.text)
+	require.NoError(t, col.ScanRow(&d, 1))
+	assert.Equal(t, "0.5", d.text)
+}
+
+// testScannerTextDecimal implements both sql.Scanner and encoding.TextUnmarshaler
+type testScannerTextDecimal struct {
+	scanned any
+	text    string
+}
+
+func (d *testScannerTextDecimal) Scan(v any) error {
+	d.scanned = v
+	return nil
+}
+
+func (d *testScannerTextDecimal) UnmarshalText(b []byte) error {
+	d.text = string(b)
+	return nil
+}
+
+func TestDecimal_ScannerBeforeTextUnmarshaler(t *testing.T) {
+	col, err := Type("Decimal(18, 2)").Column("d", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow(decimal.RequireFromString("1.25")))
+
+	var d testScannerTextDecimal
+	require.NoError(t, col.ScanRow(&d, 0))
+	assert.Equal(t, decimal.RequireFromString("1.25"), d.scanned)
+	assert.Empty(t, d.text)
+}
@@ -157,6 +157,7 @@ func extractEnumNamedValues(chType Type) (typ string, values []string, indexes [
 			values = append(values, string(foundName))
 			indexFound = false
 			valueFound = false
+			skippedValueTokens = skippedValueTokens[:0]
 			break
 		}
 	}
@@ -18,6 +18,9 @@ type Enum8 struct {
 	// Encoding of the enums that have been specified by the user.
 	// Using this when appending rows, to validate the enum is valud.
 	enumValuesBitset [4]uint64
+
+	bindings enumBindings
+	names    map[int]string
 }
 
 func (col *Enum8) Reset() {
@@ -57,9 +60,15 @@ func (col *Enum8) ScanRow(dest any, row int) error {
 		*d = new(string)
 		**d = col.vi[v]
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(col.Row(row, false))
+		}
 		if scan, ok := dest.(sql.Scanner); ok {
 			return scan.Scan(col.vi[v])
 		}
+		if ok, err := col.bindings.scanEnumType(col.chType, dest, int(v), col.enumNames()); ok {
+			return err
+		}
 		return &ColumnConverterError{
 			Op:   "ScanRow",
 			To:   fmt.Sprintf("%T", dest),
@@ -142,6 +151,12 @@ func (col *Enum8) Append(v any) (nulls []uint8, err error) {
 			}
 		}
 	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+		if nulls, ok, err := appendEnumTypes(col, v); ok {
+			return nulls, err
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -218,6 +233,13 @@ func (col *Enum8) AppendRow(elem any) error {
 	case nil:
 		col.col.Append(0)
 	default:
+		if marshaler, ok := elem.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
 		if valuer, ok := elem.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -231,6 +253,13 @@ func (col *Enum8) AppendRow(elem any) error {
 			return col.AppendRow(val)
 		}
 
+		if val, ok, err := col.bindings.enumTypeValue(col.chType, elem, col.enumNames()); ok {
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 		if s, ok := elem.(fmt.Stringer); ok {
 			return col.AppendRow(s.String())
 		} else {
@@ -244,6 +273,17 @@ func (col *Enum8) AppendRow(elem any) error {
 	return nil
 }
 
+// enumNames returns the names of the enum by their numeric value.
+func (col *Enum8) enumNames() map[int]string {
+	if col.names == nil {
+		col.names = make(map[int]string, len(col.vi))
+		for value, name := range col.vi {
+			col.names[int(value)] = name
+		}
+	}
+	return col.names
+}
+
 func (col *Enum8) Decode(reader *proto.Reader, rows int) error {
 	return col.col.DecodeColumn(reader, rows)
 }
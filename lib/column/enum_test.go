@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExtractEnumNamedValues(t *testing.T) {
@@ -33,6 +34,16 @@ func TestExtractEnumNamedValues(t *testing.T) {
 				2: "b",
 			},
 		},
+		{
+			name:         "Enum8 with escaped quote followed by other values",
+			chType:       "Enum8('it\\'s'=1,'abc'=2,'def'=3)",
+			expectedType: "Enum8",
+			expectedValues: map[int]string{
+				1: "it's",
+				2: "abc",
+				3: "def",
+			},
+		},
 		{
 			name:         "Enum8 with comma in value",
 			chType:       "Enum8('a'=1,'b'=2,'c,d'=3)",
@@ -214,3 +225,61 @@ func createValidEnumsRange(min, max int) []int {
 	}
 	return resultRange
 }
+
+type testStatus int8
+
+const (
+	testStatusActive   testStatus = 1
+	testStatusInactive testStatus = 2
+)
+
+func (testStatus) EnumValues() map[string]int {
+	return map[string]int{"active": 1, "inactive": 2}
+}
+
+type testLevel string
+
+func (testLevel) EnumValues() map[string]int {
+	return map[string]int{"low": 100, "high": 200}
+}
+
+func TestEnumTypeBinding(t *testing.T) {
+	col, err := Type("Enum8('active' = 1, 'inactive' = 2, 'deleted' = 3)").Column("status", nil)
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow(testStatusInactive))
+	_, err = col.Append([]testStatus{testStatusActive})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow("deleted"))
+
+	var status testStatus
+	require.NoError(t, col.ScanRow(&status, 0))
+	assert.Equal(t, testStatusInactive, status)
+	require.NoError(t, col.ScanRow(&status, 1))
+	assert.Equal(t, testStatusActive, status)
+	// values of the column unknown to the Go type can't be scanned
+	assert.ErrorContains(t, col.ScanRow(&status, 2), `"deleted" is not a member`)
+
+	levels, err := Type("Enum16('low' = 100, 'high' = 200)").Column("level", nil)
+	require.NoError(t, err)
+	require.NoError(t, levels.AppendRow(testLevel("high")))
+	var level testLevel
+	require.NoError(t, levels.ScanRow(&level, 0))
+	assert.Equal(t, testLevel("high"), level)
+}
+
+func TestEnumTypeMismatch(t *testing.T) {
+	col, err := Type("Enum8('active' = 1, 'inactive' = 3)").Column("status", nil)
+	require.NoError(t, err)
+	err = col.AppendRow(testStatusActive)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"inactive" is 3, expected 2`)
+	assert.Equal(t, 0, col.Rows())
+
+	col, err = Type("Enum16('active' = 1)").Column("status", nil)
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow("active"))
+	var status testStatus
+	err = col.ScanRow(&status, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"inactive" is not defined`)
+}
@@ -0,0 +1,165 @@
+package column
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// EnumType is implemented by Go types bound to Enum8 and Enum16 columns, e.g. the types generated by cmd/enumgen.
+// Values of integer types are scanned and appended by their numeric value, values of string types by their name.
+// The members are validated against the enum definition of the block the first time the type is used with it,
+// a member that is missing from the column or has a different value is an error.
+type EnumType interface {
+	// EnumValues returns the name and value of every member of the type.
+	EnumValues() map[string]int
+}
+
+type enumBinding struct {
+	err   error
+	known map[int]struct{} // values of the column that are members of the Go type
+}
+
+// enumBindings caches the validation of the Go enum types used with a column.
+type enumBindings map[reflect.Type]*enumBinding
+
+func (b *enumBindings) bind(chType Type, typ reflect.Type, v EnumType, vi map[int]string) *enumBinding {
+	if binding, ok := (*b)[typ]; ok {
+		return binding
+	}
+
+	if *b == nil {
+		*b = make(enumBindings)
+	}
+
+	iv := make(map[string]int, len(vi))
+	for value, name := range vi {
+		iv[name] = value
+	}
+
+	binding := &enumBinding{known: make(map[int]struct{})}
+	var mismatches []string
+	for name, value := range v.EnumValues() {
+		switch columnValue, ok := iv[name]; {
+		case !ok:
+			mismatches = append(mismatches, fmt.Sprintf("%q is not defined", name))
+		case columnValue != value:
+			mismatches = append(mismatches, fmt.Sprintf("%q is %d, expected %d", name, columnValue, value))
+		default:
+			binding.known[value] = struct{}{}
+		}
+	}
+
+	if len(mismatches) != 0 {
+		sort.Strings(mismatches)
+		binding.err = &Error{
+			ColumnType: string(chType),
+			Err:        fmt.Errorf("enum type %s does not match the column: %s", typ, strings.Join(mismatches, ", ")),
+		}
+	}
+
+	(*b)[typ] = binding
+	return binding
+}
+
+// scanEnumType scans the value into dest if it points to an EnumType.
+func (b *enumBindings) scanEnumType(chType Type, dest any, value int, vi map[int]string) (bool, error) {
+	v, ok := dest.(EnumType)
+	if !ok {
+		return false, nil
+	}
+
+	target := reflect.ValueOf(dest)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return false, nil
+	}
+
+	elem := target.Elem()
+	binding := b.bind(chType, elem.Type(), v, vi)
+	if binding.err != nil {
+		return true, binding.err
+	}
+
+	if _, ok := binding.known[value]; !ok {
+		return true, &Error{
+			ColumnType: string(chType),
+			Err:        fmt.Errorf("%q is not a member of enum type %s", vi[value], elem.Type()),
+		}
+	}
+
+	switch elem.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		elem.SetInt(int64(value))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		elem.SetUint(uint64(value))
+	case reflect.String:
+		elem.SetString(vi[value])
+	default:
+		return true, &ColumnConverterError{
+			Op:   "ScanRow",
+			To:   fmt.Sprintf("%T", dest),
+			From: string(chType),
+			Hint: "enum types must be an integer or string type",
+		}
+	}
+
+	return true, nil
+}
+
+// enumTypeValue returns the value to append for v, its numeric value as int or its name as string.
+func (b *enumBindings) enumTypeValue(chType Type, v any, vi map[int]string) (any, bool, error) {
+	enumType, ok := v.(EnumType)
+	if !ok {
+		return nil, false, nil
+	}
+
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil, true, nil
+		}
+		value = value.Elem()
+	}
+
+	if binding := b.bind(chType, value.Type(), enumType, vi); binding.err != nil {
+		return nil, true, binding.err
+	}
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(value.Int()), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(value.Uint()), true, nil
+	case reflect.String:
+		return value.String(), true, nil
+	}
+
+	return nil, true, &ColumnConverterError{
+		Op:   "AppendRow",
+		To:   string(chType),
+		From: fmt.Sprintf("%T", v),
+		Hint: "enum types must be an integer or string type",
+	}
+}
+
+var enumTypeType = reflect.TypeOf((*EnumType)(nil)).Elem()
+
+// appendEnumTypes appends a slice of EnumType values.
+func appendEnumTypes(col Interface, v any) (nulls []uint8, ok bool, err error) {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice || !value.Type().Elem().Implements(enumTypeType) {
+		return nil, false, nil
+	}
+	nulls = make([]uint8, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		elem := value.Index(i)
+		if elem.Kind() == reflect.Pointer && elem.IsNil() {
+			nulls[i] = 1
+		}
+		if err := col.AppendRow(elem.Interface()); err != nil {
+			return nil, true, err
+		}
+	}
+	return nulls, true, nil
+}
@@ -1,6 +1,7 @@
 package column
 
 import (
+	"bytes"
 	"database/sql"
 	"database/sql/driver"
 	"encoding"
@@ -13,8 +14,9 @@ import (
 )
 
 type FixedString struct {
-	name string
-	col  proto.ColFixedStr
+	name     string
+	col      proto.ColFixedStr
+	zeroCopy bool
 }
 
 func (col *FixedString) Reset() {
@@ -52,17 +54,40 @@ func (col *FixedString) Row(i int, ptr bool) any {
 	return value
 }
 
+// UnsafeBytes returns the value at row i as a view of the column buffer.
+// The slice is only valid until the column is reset or the next block is read and must not be modified.
+func (col *FixedString) UnsafeBytes(i int) []byte {
+	end := (i + 1) * col.col.Size
+	return col.col.Buf[i*col.col.Size : end : end]
+}
+
+// UnsafeString returns the value at row i as a string sharing memory with the column buffer.
+// The string is only valid until the column is reset or the next block is read.
+func (col *FixedString) UnsafeString(i int) string {
+	return binary.Bytes2Str(col.UnsafeBytes(i))
+}
+
 func (col *FixedString) ScanRow(dest any, row int) error {
 	switch d := dest.(type) {
 	case *string:
+		if col.zeroCopy {
+			*d = col.UnsafeString(row)
+			return nil
+		}
 		*d = col.row(row)
 	case **string:
 		*d = new(string)
 		**d = col.row(row)
+	case ColumnUnmarshaler:
+		return d.UnmarshalColumn(col.Row(row, false))
 	case encoding.BinaryUnmarshaler:
 		return d.UnmarshalBinary(col.rowBytes(row))
 	case *[]byte:
-		*d = col.rowBytes(row)
+		if col.zeroCopy {
+			*d = col.UnsafeBytes(row)
+			return nil
+		}
+		*d = bytes.Clone(col.rowBytes(row))
 	default:
 		// handle for *[n]byte
 		if t := reflect.TypeOf(dest); t.Kind() == reflect.Pointer &&
@@ -188,6 +213,9 @@ func (col *FixedString) Append(v any) (nulls []uint8, err error) {
 			}
 		}
 	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
 		// handle for [][n]byte
 		if t := reflect.TypeOf(v); t.Kind() == reflect.Slice &&
 			t.Elem().Kind() == reflect.Array &&
@@ -254,6 +282,12 @@ func (col *FixedString) AppendRow(v any) error {
 		if err != nil {
 			return err
 		}
+	case ColumnMarshaler:
+		val, err := marshalColumn(col, "AppendRow", v)
+		if err != nil {
+			return err
+		}
+		return col.AppendRow(val)
 	case encoding.BinaryMarshaler:
 		data, err := v.MarshalBinary()
 		if err != nil {
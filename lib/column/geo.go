@@ -0,0 +1,266 @@
+package column
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/encoding/ewkb"
+	"github.com/paulmach/orb/encoding/wkb"
+	"github.com/paulmach/orb/encoding/wkt"
+)
+
+// decodeGeometry decodes geometries sent in a text or binary format: WKT as string,
+// WKB or EWKB as []byte and GeoJSON as json.RawMessage.
+func decodeGeometry(v any) (orb.Geometry, bool, error) {
+	switch v := v.(type) {
+	case string:
+		g, err := wkt.Unmarshal(v)
+		return g, true, err
+	case *string:
+		if v == nil {
+			return nil, false, nil
+		}
+		return decodeGeometry(*v)
+	case []byte:
+		// EWKB is a superset of WKB, which also accepts values with an SRID e.g. from PostGIS
+		g, _, err := ewkb.Unmarshal(v)
+		return g, true, err
+	case *[]byte:
+		if v == nil {
+			return nil, false, nil
+		}
+		return decodeGeometry(*v)
+	case json.RawMessage:
+		g, err := unmarshalGeoJSON(v)
+		return g, true, err
+	}
+	return nil, false, nil
+}
+
+// convertGeometry converts g into the value stored by a column of type t,
+// e.g. a Polygon with a single ring for a Ring column or a Polygon for a MultiPolygon column.
+func convertGeometry(g orb.Geometry, t Type) (orb.Geometry, bool) {
+	switch t {
+	case "Point":
+		switch g := g.(type) {
+		case orb.Point:
+			return g, true
+		}
+	case "Ring":
+		switch g := g.(type) {
+		case orb.Ring:
+			return g, true
+		case orb.LineString:
+			return orb.Ring(g), true
+		case orb.Polygon:
+			if len(g) == 1 {
+				return g[0], true
+			}
+		}
+	case "LineString":
+		switch g := g.(type) {
+		case orb.LineString:
+			return g, true
+		case orb.Ring:
+			return orb.LineString(g), true
+		}
+	case "MultiLineString":
+		switch g := g.(type) {
+		case orb.MultiLineString:
+			return g, true
+		case orb.LineString:
+			return orb.MultiLineString{g}, true
+		}
+	case "Polygon":
+		switch g := g.(type) {
+		case orb.Polygon:
+			return g, true
+		case orb.Ring:
+			return orb.Polygon{g}, true
+		}
+	case "MultiPolygon":
+		switch g := g.(type) {
+		case orb.MultiPolygon:
+			return g, true
+		case orb.Polygon:
+			return orb.MultiPolygon{g}, true
+		case orb.Ring:
+			return orb.MultiPolygon{{g}}, true
+		}
+	case "Geometry":
+		if _, ok := geometryType(g); ok {
+			return g, true
+		}
+	}
+	return nil, false
+}
+
+// appendGeometry appends a geometry in WKT, WKB or GeoJSON format to a geo column.
+func appendGeometry(col Interface, v any) (bool, error) {
+	g, ok, err := decodeGeometry(v)
+	if !ok {
+		return false, nil
+	}
+	if _, unsupported := err.(*UnsupportedGeoJSONError); unsupported {
+		return true, err
+	}
+	if err != nil {
+		return true, &ColumnConverterError{
+			Op:   "AppendRow",
+			To:   string(col.Type()),
+			From: fmt.Sprintf("%T", v),
+			Hint: err.Error(),
+		}
+	}
+	converted, ok := convertGeometry(g, col.Type())
+	if !ok {
+		return true, &ColumnConverterError{
+			Op:   "AppendRow",
+			To:   string(col.Type()),
+			From: fmt.Sprintf("%T", g),
+			Hint: fmt.Sprintf("%s can't be stored as %s", g.GeoJSONType(), col.Type()),
+		}
+	}
+	return true, col.AppendRow(converted)
+}
+
+// appendGeometries appends slices of geometries in WKT, WKB or GeoJSON format to a geo column.
+func appendGeometries(col Interface, v any) (nulls []uint8, ok bool, err error) {
+	switch v.(type) {
+	case []string, [][]byte, []json.RawMessage:
+	default:
+		return nil, false, nil
+	}
+	values := reflect.ValueOf(v)
+	nulls = make([]uint8, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		if err := col.AppendRow(values.Index(i).Interface()); err != nil {
+			return nil, true, err
+		}
+	}
+	return nulls, true, nil
+}
+
+// scanGeometry scans g as WKT into *string, as WKB into *[]byte and as GeoJSON into *json.RawMessage.
+func scanGeometry(dest any, g orb.Geometry) (bool, error) {
+	switch d := dest.(type) {
+	case *orb.Geometry:
+		*d = g
+	case *string:
+		*d = wkt.MarshalString(g)
+	case *[]byte:
+		data, err := wkb.Marshal(g)
+		if err != nil {
+			return true, err
+		}
+		*d = data
+	case *json.RawMessage:
+		data, err := marshalGeoJSON(g)
+		if err != nil {
+			return true, err
+		}
+		*d = data
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// geometryType returns the ClickHouse type of the geometries stored in a Geometry column.
+func geometryType(g orb.Geometry) (string, bool) {
+	switch g.(type) {
+	case orb.Point:
+		return "Point", true
+	case orb.Ring:
+		return "Ring", true
+	case orb.LineString:
+		return "LineString", true
+	case orb.MultiLineString:
+		return "MultiLineString", true
+	case orb.Polygon:
+		return "Polygon", true
+	case orb.MultiPolygon:
+		return "MultiPolygon", true
+	}
+	return "", false
+}
+
+// UnsupportedGeoJSONError is returned for GeoJSON objects other than the geometries of a single type,
+// e.g. a GeometryCollection, a Feature or a FeatureCollection.
+type UnsupportedGeoJSONError struct {
+	Type string
+}
+
+func (e *UnsupportedGeoJSONError) Error() string {
+	return fmt.Sprintf("clickhouse: unsupported GeoJSON type %q, only Point, MultiPoint, LineString, "+
+		"MultiLineString, Polygon and MultiPolygon geometries are supported", e.Type)
+}
+
+// geoJSONGeometry is a GeoJSON geometry object, see https://datatracker.ietf.org/doc/html/rfc7946#section-3.1.
+// It is encoded by hand rather than with orb/geojson to keep the dependencies of the driver small.
+type geoJSONGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// marshalGeoJSON encodes g as a GeoJSON geometry object. A Ring is encoded as a Polygon with a single ring,
+// as GeoJSON has no ring type.
+func marshalGeoJSON(g orb.Geometry) ([]byte, error) {
+	var coordinates any
+	switch g := g.(type) {
+	case orb.Point, orb.MultiPoint, orb.LineString, orb.MultiLineString, orb.Polygon, orb.MultiPolygon:
+		coordinates = g
+	case orb.Ring:
+		coordinates = orb.Polygon{g}
+	default:
+		return nil, fmt.Errorf("%T can't be encoded as a GeoJSON geometry", g)
+	}
+	data, err := json.Marshal(coordinates)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(geoJSONGeometry{
+		Type:        g.GeoJSONType(),
+		Coordinates: data,
+	})
+}
+
+// unmarshalGeoJSON decodes a GeoJSON geometry object. Geometry collections and features are not supported.
+func unmarshalGeoJSON(data []byte) (orb.Geometry, error) {
+	var object geoJSONGeometry
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	var (
+		g   orb.Geometry
+		err error
+	)
+	switch object.Type {
+	case "Point":
+		g, err = unmarshalGeoJSONCoordinates[orb.Point](object.Coordinates)
+	case "MultiPoint":
+		g, err = unmarshalGeoJSONCoordinates[orb.MultiPoint](object.Coordinates)
+	case "LineString":
+		g, err = unmarshalGeoJSONCoordinates[orb.LineString](object.Coordinates)
+	case "MultiLineString":
+		g, err = unmarshalGeoJSONCoordinates[orb.MultiLineString](object.Coordinates)
+	case "Polygon":
+		g, err = unmarshalGeoJSONCoordinates[orb.Polygon](object.Coordinates)
+	case "MultiPolygon":
+		g, err = unmarshalGeoJSONCoordinates[orb.MultiPolygon](object.Coordinates)
+	default:
+		return nil, &UnsupportedGeoJSONError{Type: object.Type}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON %s coordinates: %w", object.Type, err)
+	}
+	return g, nil
+}
+
+func unmarshalGeoJSONCoordinates[T orb.Geometry](data []byte) (orb.Geometry, error) {
+	var g T
+	err := json.Unmarshal(data, &g)
+	return g, err
+}
@@ -0,0 +1,205 @@
+package column
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/paulmach/orb"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
+)
+
+// geometryVariant is the Variant a Geometry column is serialized as.
+const geometryVariant = "Variant(LineString, MultiLineString, MultiPolygon, Point, Polygon, Ring)"
+
+// Geometry holds any of the geo types, values are exposed as orb.Geometry.
+//
+// Like the other geo columns it appends and scans WKT, WKB and GeoJSON. Only GeoJSON geometry objects of
+// a single type are supported: a GeometryCollection, a Feature or a FeatureCollection fails with
+// UnsupportedGeoJSONError.
+type Geometry struct {
+	set  *Variant
+	name string
+}
+
+func (col *Geometry) parse(sc *ServerContext) (*Geometry, error) {
+	set, err := (&Variant{name: col.name}).parse(geometryVariant, sc)
+	if err != nil {
+		return nil, err
+	}
+	col.set = set
+	return col, nil
+}
+
+func (col *Geometry) Reset() {
+	col.set.Reset()
+}
+
+func (col *Geometry) Name() string {
+	return col.name
+}
+
+func (col *Geometry) Type() Type {
+	return "Geometry"
+}
+
+func (col *Geometry) ScanType() reflect.Type {
+	return scanTypeGeometry
+}
+
+func (col *Geometry) Rows() int {
+	return col.set.Rows()
+}
+
+func (col *Geometry) Row(i int, ptr bool) any {
+	value := col.row(i)
+	if ptr {
+		return &value
+	}
+	return value
+}
+
+func (col *Geometry) ScanRow(dest any, row int) error {
+	switch d := dest.(type) {
+	case *orb.Geometry:
+		*d = col.row(row)
+	case **orb.Geometry:
+		*d = new(orb.Geometry)
+		**d = col.row(row)
+	case *chcol.Variant, **chcol.Variant:
+		return col.set.ScanRow(dest, row)
+	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(col.Row(row, false))
+		}
+		value := col.row(row)
+		if value == nil {
+			return nil
+		}
+		if ok, err := scanGeometry(dest, value); ok {
+			return err
+		}
+		if err := col.set.ScanRow(dest, row); err != nil {
+			return &ColumnConverterError{
+				Op:   "ScanRow",
+				To:   fmt.Sprintf("%T", dest),
+				From: "Geometry",
+				Hint: fmt.Sprintf("row contains %T, try using *orb.Geometry", value),
+			}
+		}
+	}
+	return nil
+}
+
+func (col *Geometry) Append(v any) (nulls []uint8, err error) {
+	switch v := v.(type) {
+	case []orb.Geometry:
+		nulls = make([]uint8, len(v))
+		for i := range v {
+			if err := col.AppendRow(v[i]); err != nil {
+				return nil, err
+			}
+		}
+		return nulls, nil
+	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+		if nulls, ok, err := appendGeometries(col, v); ok {
+			return nulls, err
+		}
+		if valuer, ok := v.(driver.Valuer); ok {
+			val, err := valuer.Value()
+			if err != nil {
+				return nil, &ColumnConverterError{
+					Op:   "Append",
+					To:   "Geometry",
+					From: fmt.Sprintf("%T", v),
+					Hint: "could not get driver.Valuer value, try using []orb.Geometry",
+				}
+			}
+			return col.Append(val)
+		}
+		return nil, &ColumnConverterError{
+			Op:   "Append",
+			To:   "Geometry",
+			From: fmt.Sprintf("%T", v),
+		}
+	}
+}
+
+func (col *Geometry) AppendRow(v any) error {
+	switch v := v.(type) {
+	case nil:
+		return col.set.AppendRow(nil)
+	case chcol.Variant, *chcol.Variant:
+		return col.set.AppendRow(v)
+	case orb.Geometry:
+		chType, ok := geometryType(v)
+		if !ok {
+			return &ColumnConverterError{
+				Op:   "AppendRow",
+				To:   "Geometry",
+				From: fmt.Sprintf("%T", v),
+				Hint: "try using orb.Point, orb.Ring, orb.LineString, orb.MultiLineString, orb.Polygon or orb.MultiPolygon",
+			}
+		}
+		return col.set.AppendRow(chcol.NewVariantWithType(v, chType))
+	default:
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+		if ok, err := appendGeometry(col, v); ok {
+			return err
+		}
+		if valuer, ok := v.(driver.Valuer); ok {
+			val, err := valuer.Value()
+			if err != nil {
+				return &ColumnConverterError{
+					Op:   "AppendRow",
+					To:   "Geometry",
+					From: fmt.Sprintf("%T", v),
+					Hint: "could not get driver.Valuer value, try using orb.Geometry",
+				}
+			}
+			return col.AppendRow(val)
+		}
+		return &ColumnConverterError{
+			Op:   "AppendRow",
+			To:   "Geometry",
+			From: fmt.Sprintf("%T", v),
+		}
+	}
+}
+
+func (col *Geometry) ReadStatePrefix(reader *proto.Reader) error {
+	return col.set.ReadStatePrefix(reader)
+}
+
+func (col *Geometry) WriteStatePrefix(buffer *proto.Buffer) error {
+	return col.set.WriteStatePrefix(buffer)
+}
+
+func (col *Geometry) Decode(reader *proto.Reader, rows int) error {
+	return col.set.Decode(reader, rows)
+}
+
+func (col *Geometry) Encode(buffer *proto.Buffer) {
+	col.set.Encode(buffer)
+}
+
+func (col *Geometry) row(i int) orb.Geometry {
+	value, _ := col.set.Row(i, false).(chcol.Variant).Any().(orb.Geometry)
+	return value
+}
+
+var (
+	_ Interface           = (*Geometry)(nil)
+	_ CustomSerialization = (*Geometry)(nil)
+)
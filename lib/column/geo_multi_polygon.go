@@ -50,6 +50,12 @@ func (col *MultiPolygon) ScanRow(dest any, row int) error {
 		*d = new(orb.MultiPolygon)
 		**d = col.row(row)
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(col.Row(row, false))
+		}
+		if ok, err := scanGeometry(dest, col.row(row)); ok {
+			return err
+		}
 		return &ColumnConverterError{
 			Op:   "ScanRow",
 			To:   fmt.Sprintf("%T", dest),
@@ -81,6 +87,12 @@ func (col *MultiPolygon) Append(v any) (nulls []uint8, err error) {
 		}
 		return col.set.Append(values)
 	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+		if nulls, ok, err := appendGeometries(col, v); ok {
+			return nulls, err
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -108,6 +120,16 @@ func (col *MultiPolygon) AppendRow(v any) error {
 	case *orb.MultiPolygon:
 		return col.set.AppendRow([]orb.Polygon(*v))
 	default:
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+		if ok, err := appendGeometry(col, v); ok {
+			return err
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
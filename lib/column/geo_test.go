@@ -0,0 +1,149 @@
+package column
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/encoding/ewkb"
+	"github.com/paulmach/orb/encoding/wkb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeo_AppendText(t *testing.T) {
+	polygon := orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}
+	wkbData, err := wkb.Marshal(polygon)
+	require.NoError(t, err)
+	ewkbData, err := ewkb.Marshal(polygon, 4326)
+	require.NoError(t, err)
+
+	col, err := Type("Polygon").Column("p", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow("POLYGON((0 0,1 0,1 1,0 0))"))
+	require.NoError(t, col.AppendRow(wkbData))
+	require.NoError(t, col.AppendRow(ewkbData))
+	require.NoError(t, col.AppendRow(json.RawMessage(`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`)))
+	require.NoError(t, col.AppendRow(json.RawMessage(`{"coordinates":[[[0,0],[1,0],[1,1],[0,0]]],"type":"Polygon","bbox":[0,0,1,1]}`)))
+	require.Equal(t, 5, col.Rows())
+	for i := 0; i < col.Rows(); i++ {
+		assert.Equal(t, polygon, col.Row(i, false))
+	}
+
+	var converterErr *ColumnConverterError
+	require.ErrorAs(t, col.AppendRow("POINT(1 2)"), &converterErr)
+	require.ErrorAs(t, col.AppendRow("not wkt"), &converterErr)
+	var geoJSONErr *UnsupportedGeoJSONError
+	require.ErrorAs(t, col.AppendRow(json.RawMessage(`{"type":"Circle","coordinates":[0,0]}`)), &geoJSONErr)
+	require.ErrorAs(t, col.AppendRow(json.RawMessage(`{"type":"Feature","geometry":{"type":"Polygon","coordinates":[]}}`)), &geoJSONErr)
+	assert.Equal(t, "Feature", geoJSONErr.Type)
+	require.ErrorAs(t, col.AppendRow(json.RawMessage(`{"type":"GeometryCollection","geometries":[]}`)), &geoJSONErr)
+	assert.Equal(t, 5, col.Rows())
+	require.ErrorAs(t, col.AppendRow(json.RawMessage(`{"type":"Polygon","coordinates":[0,0]}`)), &converterErr)
+}
+
+func TestGeo_AppendConverted(t *testing.T) {
+	multi, err := Type("MultiPolygon").Column("mp", &ServerContext{})
+	require.NoError(t, err)
+	_, err = multi.Append([]string{"POLYGON((0 0,1 0,1 1,0 0))", "MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2)))"})
+	require.NoError(t, err)
+	assert.Equal(t, orb.MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}}, multi.Row(0, false))
+
+	ring, err := Type("Ring").Column("r", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, ring.AppendRow("POLYGON((0 0,1 0,1 1,0 0))"))
+	assert.Equal(t, orb.Ring{{0, 0}, {1, 0}, {1, 1}, {0, 0}}, ring.Row(0, false))
+}
+
+func TestGeo_ScanText(t *testing.T) {
+	col, err := Type("Point").Column("p", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow(orb.Point{1.5, 2}))
+
+	var s string
+	require.NoError(t, col.ScanRow(&s, 0))
+	assert.Equal(t, "POINT(1.5 2)", s)
+
+	var b []byte
+	require.NoError(t, col.ScanRow(&b, 0))
+	g, err := wkb.Unmarshal(b)
+	require.NoError(t, err)
+	assert.Equal(t, orb.Point{1.5, 2}, g)
+
+	var raw json.RawMessage
+	require.NoError(t, col.ScanRow(&raw, 0))
+	assert.JSONEq(t, `{"type":"Point","coordinates":[1.5,2]}`, string(raw))
+
+}
+
+func TestGeo_GeoJSON(t *testing.T) {
+	geometries := map[string]orb.Geometry{
+		`{"type":"Point","coordinates":[1.5,2]}`:                                  orb.Point{1.5, 2},
+		`{"type":"LineString","coordinates":[[1,2],[3,4]]}`:                       orb.LineString{{1, 2}, {3, 4}},
+		`{"type":"MultiLineString","coordinates":[[[1,2],[3,4]]]}`:                orb.MultiLineString{{{1, 2}, {3, 4}}},
+		`{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`:                  orb.Polygon{{{0, 0}, {1, 0}, {0, 0}}},
+		`{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[0,0]]],[[[2,2]]]]}`: orb.MultiPolygon{{{{0, 0}, {1, 0}, {0, 0}}}, {{{2, 2}}}},
+	}
+	for expected, g := range geometries {
+		data, err := marshalGeoJSON(g)
+		require.NoError(t, err)
+		assert.JSONEq(t, expected, string(data))
+		decoded, err := unmarshalGeoJSON(data)
+		require.NoError(t, err)
+		assert.Equal(t, g, decoded)
+	}
+
+	// GeoJSON has no ring type
+	data, err := marshalGeoJSON(orb.Ring{{0, 0}, {1, 0}, {0, 0}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`, string(data))
+}
+
+func TestGeometry(t *testing.T) {
+	col, err := Type("Geometry").Column("g", &ServerContext{})
+	require.NoError(t, err)
+	assert.Equal(t, Type("Geometry"), col.Type())
+
+	values := []orb.Geometry{
+		orb.Point{1, 2},
+		orb.LineString{{1, 2}, {3, 4}},
+		orb.Ring{{0, 0}, {1, 0}, {0, 0}},
+		orb.Polygon{{{0, 0}, {1, 0}, {0, 0}}},
+	}
+	_, err = col.Append(values)
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow("MULTILINESTRING((1 2,3 4))"))
+	require.NoError(t, col.AppendRow(nil))
+
+	var converterErr *ColumnConverterError
+	require.ErrorAs(t, col.AppendRow(orb.MultiPoint{{1, 2}}), &converterErr)
+
+	var buffer proto.Buffer
+	require.NoError(t, col.(CustomSerialization).WriteStatePrefix(&buffer))
+	col.Encode(&buffer)
+
+	decoded, err := Type("Geometry").Column("g", &ServerContext{})
+	require.NoError(t, err)
+	reader := proto.NewReader(buffer.Reader())
+	require.NoError(t, decoded.(CustomSerialization).ReadStatePrefix(reader))
+	require.NoError(t, decoded.Decode(reader, col.Rows()))
+
+	for i, expected := range values {
+		var g orb.Geometry
+		require.NoError(t, decoded.ScanRow(&g, i))
+		assert.Equal(t, expected, g)
+		assert.Equal(t, expected, col.Row(i, false))
+	}
+	var mls orb.MultiLineString
+	require.NoError(t, decoded.ScanRow(&mls, 4))
+	assert.Equal(t, orb.MultiLineString{{{1, 2}, {3, 4}}}, mls)
+	assert.Nil(t, decoded.Row(5, false))
+
+	var s string
+	require.NoError(t, decoded.ScanRow(&s, 1))
+	assert.Equal(t, "LINESTRING(1 2,3 4)", s)
+
+	var p orb.Point
+	require.ErrorAs(t, decoded.ScanRow(&p, 1), &converterErr)
+}
@@ -1,16 +1,22 @@
 package column
 
 import (
-	"errors"
+	"database/sql"
+	"database/sql/driver"
 	"fmt"
-	"github.com/ClickHouse/ch-go/proto"
 	"reflect"
 	"strings"
+	"time"
+
+	"github.com/ClickHouse/ch-go/proto"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
 )
 
 type Interval struct {
 	chType Type
 	name   string
+	unit   chcol.IntervalUnit
 	col    proto.ColInt64
 }
 
@@ -23,8 +29,9 @@ func (col *Interval) Name() string {
 }
 
 func (col *Interval) parse(t Type) (Interface, error) {
-	switch col.chType = t; col.chType {
-	case "IntervalNanosecond", "IntervalMicrosecond", "IntervalMillisecond", "IntervalSecond", "IntervalMinute", "IntervalHour", "IntervalDay", "IntervalWeek", "IntervalMonth", "IntervalQuarter", "IntervalYear":
+	col.chType = t
+	if unit := chcol.IntervalUnit(strings.TrimPrefix(string(t), "Interval")); unit.Valid() {
+		col.unit = unit
 		return col, nil
 	}
 	return nil, &UnsupportedColumnTypeError{
@@ -49,44 +56,191 @@ func (col *Interval) ScanRow(dest any, row int) error {
 	case **string:
 		*d = new(string)
 		**d = col.row(row)
+	case *int64:
+		*d = col.col.Row(row)
+	case **int64:
+		*d = new(int64)
+		**d = col.col.Row(row)
+	case *chcol.Interval:
+		*d = col.interval(row)
+	case **chcol.Interval:
+		*d = new(chcol.Interval)
+		**d = col.interval(row)
+	case *time.Duration:
+		v, err := col.duration(dest, row)
+		if err != nil {
+			return err
+		}
+		*d = v
+	case **time.Duration:
+		v, err := col.duration(dest, row)
+		if err != nil {
+			return err
+		}
+		*d = new(time.Duration)
+		**d = v
 	default:
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(col.interval(row))
+		}
+		if scan, ok := dest.(sql.Scanner); ok {
+			return scan.Scan(col.col.Row(row))
+		}
 		return &ColumnConverterError{
 			Op:   "ScanRow",
 			To:   fmt.Sprintf("%T", dest),
 			From: "Interval",
+			Hint: "try using *string, *int64, *time.Duration or *chcol.Interval",
 		}
 	}
 	return nil
 }
 
-func (Interval) Append(any) ([]uint8, error) {
-	return nil, &Error{
-		ColumnType: "Interval",
-		Err:        errors.New("data type values can't be stored in tables"),
+func (col *Interval) duration(dest any, row int) (time.Duration, error) {
+	d, err := col.interval(row).Duration()
+	if err != nil {
+		return 0, &ColumnConverterError{
+			Op:   "ScanRow",
+			To:   fmt.Sprintf("%T", dest),
+			From: string(col.chType),
+			Hint: err.Error() + ", try using *chcol.Interval",
+		}
 	}
+	return d, nil
 }
 
-func (Interval) AppendRow(any) error {
-	return &Error{
-		ColumnType: "Interval",
-		Err:        errors.New("data type values can't be stored in tables"),
+func (col *Interval) Append(v any) (nulls []uint8, err error) {
+	switch v := v.(type) {
+	case []int64:
+		col.col.AppendArr(v)
+		nulls = make([]uint8, len(v))
+	case []time.Duration:
+		nulls = make([]uint8, len(v))
+		for i := range v {
+			if err := col.AppendRow(v[i]); err != nil {
+				return nil, err
+			}
+		}
+	case []chcol.Interval:
+		nulls = make([]uint8, len(v))
+		for i := range v {
+			if err := col.AppendRow(v[i]); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+		return nil, &ColumnConverterError{
+			Op:   "Append",
+			To:   string(col.chType),
+			From: fmt.Sprintf("%T", v),
+		}
 	}
+	return
+}
+
+func (col *Interval) AppendRow(v any) error {
+	switch v := v.(type) {
+	case int64:
+		col.col.Append(v)
+	case *int64:
+		switch {
+		case v != nil:
+			col.col.Append(*v)
+		default:
+			col.col.Append(0)
+		}
+	case time.Duration:
+		interval, err := chcol.NewInterval(v, col.unit)
+		if err != nil {
+			return &ColumnConverterError{
+				Op:   "AppendRow",
+				To:   string(col.chType),
+				From: "time.Duration",
+				Hint: err.Error(),
+			}
+		}
+		col.col.Append(interval.Value)
+	case *time.Duration:
+		switch {
+		case v != nil:
+			return col.AppendRow(*v)
+		default:
+			col.col.Append(0)
+		}
+	case chcol.Interval:
+		if v.Unit == col.unit {
+			col.col.Append(v.Value)
+			return nil
+		}
+		// sub-day units can be converted as long as no precision is lost
+		d, err := v.Duration()
+		if err == nil {
+			return col.AppendRow(d)
+		}
+		return &ColumnConverterError{
+			Op:   "AppendRow",
+			To:   string(col.chType),
+			From: "Interval" + string(v.Unit),
+			Hint: err.Error(),
+		}
+	case *chcol.Interval:
+		switch {
+		case v != nil:
+			return col.AppendRow(*v)
+		default:
+			col.col.Append(0)
+		}
+	case nil:
+		col.col.Append(0)
+	default:
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+		if valuer, ok := v.(driver.Valuer); ok {
+			val, err := valuer.Value()
+			if err != nil {
+				return &ColumnConverterError{
+					Op:   "AppendRow",
+					To:   string(col.chType),
+					From: fmt.Sprintf("%T", v),
+					Hint: "could not get driver.Valuer value",
+				}
+			}
+			return col.AppendRow(val)
+		}
+		return &ColumnConverterError{
+			Op:   "AppendRow",
+			To:   string(col.chType),
+			From: fmt.Sprintf("%T", v),
+		}
+	}
+	return nil
 }
 
 func (col *Interval) Decode(reader *proto.Reader, rows int) error {
 	return col.col.DecodeColumn(reader, rows)
 }
 
-func (Interval) Encode(buffer *proto.Buffer) {
+func (col *Interval) Encode(buffer *proto.Buffer) {
+	col.col.EncodeColumn(buffer)
 }
 
-func (col *Interval) row(i int) string {
-	val := col.col.Row(i)
-	v := fmt.Sprintf("%d %s", val, strings.TrimPrefix(string(col.chType), "Interval"))
-	if val > 1 {
-		v += "s"
+func (col *Interval) interval(i int) chcol.Interval {
+	return chcol.Interval{
+		Value: col.col.Row(i),
+		Unit:  col.unit,
 	}
-	return v
+}
+
+func (col *Interval) row(i int) string {
+	return col.interval(i).String()
 }
 
 var _ Interface = (*Interval)(nil)
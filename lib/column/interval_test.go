@@ -0,0 +1,86 @@
+package column
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
+)
+
+func TestInterval_ScanRow(t *testing.T) {
+	col, err := Type("IntervalSecond").Column("i", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow(int64(90)))
+	require.NoError(t, col.AppendRow(-2*time.Minute))
+	require.NoError(t, col.AppendRow(chcol.Interval{Value: 1, Unit: chcol.IntervalHour}))
+
+	var (
+		s        string
+		v        int64
+		d        time.Duration
+		interval chcol.Interval
+	)
+	require.NoError(t, col.ScanRow(&s, 0))
+	assert.Equal(t, "90 Seconds", s)
+	require.NoError(t, col.ScanRow(&v, 1))
+	assert.Equal(t, int64(-120), v)
+	require.NoError(t, col.ScanRow(&d, 0))
+	assert.Equal(t, 90*time.Second, d)
+	require.NoError(t, col.ScanRow(&interval, 2))
+	assert.Equal(t, chcol.Interval{Value: 3600, Unit: chcol.IntervalSecond}, interval)
+
+	var pd *time.Duration
+	require.NoError(t, col.ScanRow(&pd, 2))
+	assert.Equal(t, time.Hour, *pd)
+}
+
+func TestInterval_CalendarUnits(t *testing.T) {
+	col, err := Type("IntervalMonth").Column("i", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow(chcol.Interval{Value: 2, Unit: chcol.IntervalMonth}))
+
+	var interval chcol.Interval
+	require.NoError(t, col.ScanRow(&interval, 0))
+	assert.Equal(t, chcol.Interval{Value: 2, Unit: chcol.IntervalMonth}, interval)
+
+	var d time.Duration
+	var converterErr *ColumnConverterError
+	require.ErrorAs(t, col.ScanRow(&d, 0), &converterErr)
+	require.ErrorAs(t, col.AppendRow(time.Hour), &converterErr)
+	require.ErrorAs(t, col.AppendRow(chcol.Interval{Value: 1, Unit: chcol.IntervalYear}), &converterErr)
+}
+
+func TestInterval_AppendLosesPrecision(t *testing.T) {
+	col, err := Type("IntervalMinute").Column("i", &ServerContext{})
+	require.NoError(t, err)
+	var converterErr *ColumnConverterError
+	require.ErrorAs(t, col.AppendRow(90*time.Second), &converterErr)
+	_, err = col.Append([]time.Duration{time.Minute, time.Hour})
+	require.NoError(t, err)
+	assert.Equal(t, 2, col.Rows())
+}
+
+func TestInterval_EncodeDecode(t *testing.T) {
+	col, err := Type("IntervalNanosecond").Column("i", &ServerContext{})
+	require.NoError(t, err)
+	_, err = col.Append([]int64{1, 2, 3})
+	require.NoError(t, err)
+	var buffer proto.Buffer
+	col.Encode(&buffer)
+
+	decoded, err := Type("IntervalNanosecond").Column("i", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, decoded.Decode(proto.NewReader(buffer.Reader()), 3))
+	var d time.Duration
+	require.NoError(t, decoded.ScanRow(&d, 2))
+	assert.Equal(t, 3*time.Nanosecond, d)
+}
+
+func TestInterval_DurationOverflow(t *testing.T) {
+	_, err := chcol.Interval{Value: 1 << 40, Unit: chcol.IntervalHour}.Duration()
+	assert.Error(t, err)
+}
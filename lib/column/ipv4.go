@@ -82,6 +82,8 @@ func (col *IPv4) ScanRow(dest any, row int) error {
 		}
 		*d = new(uint32)
 		**d = binary.BigEndian.Uint32(ipV4[:])
+	case ColumnUnmarshaler:
+		return d.UnmarshalColumn(col.Row(row, false))
 	case sql.Scanner:
 		return d.Scan(col.row(row))
 	default:
@@ -190,6 +192,9 @@ func (col *IPv4) Append(v any) (nulls []uint8, err error) {
 			}
 		}
 	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -266,6 +271,13 @@ func (col *IPv4) AppendRow(v any) (err error) {
 			col.col.Append(0)
 		}
 	default:
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -75,6 +75,8 @@ func (col *IPv6) ScanRow(dest any, row int) error {
 	case **[16]byte:
 		*d = new([16]byte)
 		**d = col.col.Row(row)
+	case ColumnUnmarshaler:
+		return d.UnmarshalColumn(col.Row(row, false))
 	case sql.Scanner:
 		return d.Scan(col.row(row))
 	default:
@@ -218,6 +220,9 @@ func (col *IPv6) Append(v any) (nulls []uint8, err error) {
 			}
 		}
 	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -314,6 +319,13 @@ func (col *IPv6) AppendRow(v any) (err error) {
 	case nil:
 		col.col.Append([16]byte{})
 	default:
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -1,9 +1,13 @@
 package column
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -42,6 +46,10 @@ type JSON struct {
 
 	maxDynamicPaths int
 	maxDynamicTypes int
+
+	// typed and dynamic paths in sorted order for WriteRowJSON, rebuilt when paths are added
+	sortedPaths   []string
+	sortedColumns []Interface
 }
 
 func (c *JSON) parse(t Type, sc *ServerContext) (_ *JSON, err error) {
@@ -222,6 +230,47 @@ func (c *JSON) rowAsJSON(row int) *chcol.JSON {
 	return obj
 }
 
+// WriteRowJSON writes row as a JSON object to w, reading the values directly from the typed and dynamic path columns
+// instead of building a chcol.JSON. Keys are written in sorted order, NULL dynamic paths are omitted.
+// With the string serialization the JSON text is written as received from the server.
+func (c *JSON) WriteRowJSON(w io.Writer, row int) error {
+	switch c.serializationVersion {
+	case JSONObjectSerializationVersion:
+		paths, columns := c.pathsInOrder()
+		return chcol.EncodeJSONPaths(w, paths, func(i int) any {
+			return columns[i].Row(row, false)
+		})
+	case JSONStringSerializationVersion:
+		_, err := w.Write(c.jsonStrings.col.RowBytes(row))
+		return err
+	default:
+		return fmt.Errorf("unsupported JSON serialization version for write: %d", c.serializationVersion)
+	}
+}
+
+func (c *JSON) pathsInOrder() ([]string, []Interface) {
+	if c.sortedPaths != nil {
+		return c.sortedPaths, c.sortedColumns
+	}
+
+	paths := make([]string, 0, len(c.typedPaths)+len(c.dynamicPaths))
+	paths = append(paths, c.typedPaths...)
+	paths = append(paths, c.dynamicPaths...)
+	slices.Sort(paths)
+
+	columns := make([]Interface, len(paths))
+	for i, path := range paths {
+		if colIndex, ok := c.typedPathsIndex[path]; ok {
+			columns[i] = c.typedColumns[colIndex]
+		} else {
+			columns[i] = c.dynamicColumns[c.dynamicPathsIndex[path]]
+		}
+	}
+
+	c.sortedPaths, c.sortedColumns = paths, columns
+	return paths, columns
+}
+
 func (c *JSON) Name() string {
 	return c.name
 }
@@ -252,6 +301,19 @@ func (c *JSON) Row(row int, ptr bool) any {
 }
 
 func (c *JSON) ScanRow(dest any, row int) error {
+	switch d := dest.(type) {
+	case *json.RawMessage:
+		var buf bytes.Buffer
+		if err := c.WriteRowJSON(&buf, row); err != nil {
+			return err
+		}
+
+		*d = buf.Bytes()
+		return nil
+	case io.Writer:
+		return c.WriteRowJSON(d, row)
+	}
+
 	switch c.serializationVersion {
 	case JSONObjectSerializationVersion:
 		return c.scanRowObject(dest, row)
@@ -272,6 +334,12 @@ func (c *JSON) scanRowObject(dest any, row int) error {
 		obj := c.rowAsJSON(row)
 		**v = *obj
 		return nil
+	case *strictJSON:
+		if err := c.unexpectedDynamicPaths(v.dest, row); err != nil {
+			return err
+		}
+
+		return c.scanRowObject(v.dest, row)
 	case chcol.JSONDeserializer:
 		obj := c.rowAsJSON(row)
 		err := v.DeserializeClickHouseJSON(obj)
@@ -295,6 +363,10 @@ func (c *JSON) scanRowObject(dest any, row int) error {
 }
 
 func (c *JSON) scanRowString(dest any, row int) error {
+	if strict, ok := dest.(*strictJSON); ok {
+		dest = strict.dest
+	}
+
 	return c.jsonStrings.ScanRow(dest, row)
 }
 
@@ -359,6 +431,15 @@ func (c *JSON) appendObject(v any) (nulls []uint8, err error) {
 			}
 		}
 
+		return nil, nil
+	case []json.RawMessage:
+		for i, obj := range vv {
+			err := c.AppendRow(obj)
+			if err != nil {
+				return nil, fmt.Errorf("failed to AppendRow at index %d: %w", i, err)
+			}
+		}
+
 		return nil, nil
 	}
 
@@ -436,6 +517,16 @@ func (c *JSON) appendRowObject(v any) error {
 		if err != nil {
 			return fmt.Errorf("failed to serialize using SerializeClickHouseJSON: %w", err)
 		}
+	case json.RawMessage:
+		// paths are read straight from the JSON text without building nested maps
+		obj = chcol.NewJSON()
+		if err := obj.UnmarshalJSON(vv); err != nil {
+			return fmt.Errorf("failed to parse JSON text: %w", err)
+		}
+	case *json.RawMessage:
+		if vv != nil {
+			return c.appendRowObject(*vv)
+		}
 	}
 
 	if obj == nil && v != nil {
@@ -471,6 +562,11 @@ func (c *JSON) appendRowObject(v any) error {
 		value, _ := valuesByPath[typedPath]
 
 		col := c.typedColumns[i]
+		if dyn, ok := value.(chcol.Dynamic); ok && !isDynamicOrVariant(col) {
+			// a chType hint matching a typed path declaration, the declared type takes precedence
+			value = dyn.Any()
+		}
+
 		err := col.AppendRow(value)
 		if err != nil {
 			return fmt.Errorf("failed to append type %s to json column at typed path %s: %w", col.Type(), typedPath, err)
@@ -514,6 +610,7 @@ func (c *JSON) appendRowObject(v any) error {
 			}
 
 			c.dynamicPaths = append(c.dynamicPaths, objPath)
+			c.sortedPaths = nil
 			c.dynamicPathsIndex[objPath] = len(c.dynamicPaths) - 1
 			c.dynamicColumns = append(c.dynamicColumns, colDynamic)
 			c.totalDynamicPaths++
@@ -524,6 +621,16 @@ func (c *JSON) appendRowObject(v any) error {
 	return nil
 }
 
+// isDynamicOrVariant reports whether col stores chcol.Dynamic values as they are.
+func isDynamicOrVariant(col Interface) bool {
+	switch col.(type) {
+	case *Dynamic, *Variant:
+		return true
+	}
+
+	return false
+}
+
 func (c *JSON) appendRowString(v any) error {
 	err := c.jsonStrings.AppendRow(v)
 	if err != nil {
@@ -650,6 +757,7 @@ func (c *JSON) decodeObjectHeader(reader *proto.Reader) error {
 	c.totalDynamicPaths = int(totalDynamicPaths)
 
 	c.dynamicPaths = make([]string, 0, c.totalDynamicPaths)
+	c.sortedPaths = nil
 	for i := 0; i < c.totalDynamicPaths; i++ {
 		dynamicPath, err := reader.Str()
 		if err != nil {
@@ -8,6 +8,31 @@ import (
 	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
 )
 
+// jsonSkipType is the chType tag value of fields whose path is skipped by the JSON column, e.g. `json:"c" chType:"SKIP"`.
+const jsonSkipType = "SKIP"
+
+// jsonFieldName returns the path of a struct field relative to its parent, taken from the json tag or the field name.
+// A tag may hold a dotted path such as `json:"a.b.c"` to map a field onto a nested path directly.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	name := field.Tag.Get("json")
+	if name == "" || name[0] == ',' {
+		name = field.Name
+	} else {
+		// handle `json:"name,omitempty"`
+		name = strings.Split(name, ",")[0]
+	}
+
+	return name, name != "-"
+}
+
+func joinJSONPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
+
 // Decoding (Scanning)
 
 // scanIntoStruct will iterate the provided struct and scan JSON data into the matching fields
@@ -60,22 +85,12 @@ func (c *JSON) fillStruct(val reflect.Value, prefix string, row int) error {
 			continue
 		}
 
-		name := fieldType.Tag.Get("json")
-		if name == "" || name[0] == ',' {
-			name = fieldType.Name
-		} else {
-			name = strings.Split(name, ",")[0]
-		}
-
-		if name == "-" {
+		name, ok := jsonFieldName(fieldType)
+		if !ok || fieldType.Tag.Get("chType") == jsonSkipType {
 			continue
 		}
 
-		path := name
-		if prefix != "" {
-			path = prefix + "." + name
-		}
-
+		path := joinJSONPath(prefix, name)
 		if c.hasTypedPath(path) {
 			err := c.scanTypedPathToValue(path, row, field)
 			if err != nil {
@@ -293,25 +308,17 @@ func iterateStruct(val reflect.Value, prefix string, json *chcol.JSON) error {
 			continue
 		}
 
-		name := fieldType.Tag.Get("json")
-		if name == "" || name[0] == ',' {
-			name = fieldType.Name
-		} else {
-			// handle `json:"name,omitempty"`
-			name = strings.Split(name, ",")[0]
-		}
-
-		if name == "-" {
+		name, ok := jsonFieldName(fieldType)
+		if !ok {
 			continue
 		}
 
-		path := name
-		if prefix != "" {
-			path = prefix + "." + name
+		forcedType := fieldType.Tag.Get("chType")
+		if forcedType == jsonSkipType {
+			continue
 		}
 
-		forcedType := fieldType.Tag.Get("chType")
-		err := handleValue(field, path, json, forcedType)
+		err := handleValue(field, joinJSONPath(prefix, name), json, forcedType)
 		if err != nil {
 			return err
 		}
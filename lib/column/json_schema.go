@@ -0,0 +1,173 @@
+package column
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
+)
+
+// JSONTypeOf returns the JSON column type declared by the struct v, e.g. JSON(a.b UInt32, SKIP c).
+// Fields with a `chType` tag become typed paths, `chType:"SKIP"` skips the path and
+// all other fields are left as dynamic paths. The result can be used in DDL for the tables the struct is inserted into.
+func JSONTypeOf(v any) (Type, error) {
+	typ := reflect.TypeOf(v)
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return "", fmt.Errorf("expected struct, got %T", v)
+	}
+
+	var params []string
+	if err := jsonTypeParams(typ, "", &params); err != nil {
+		return "", err
+	}
+
+	if len(params) == 0 {
+		return "JSON", nil
+	}
+
+	return Type("JSON(" + strings.Join(params, ", ") + ")"), nil
+}
+
+func jsonTypeParams(typ reflect.Type, prefix string, params *[]string) error {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+
+		path := joinJSONPath(prefix, name)
+		switch chType := field.Tag.Get("chType"); chType {
+		case "":
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+
+			if _, ok := iterateStructSkipTypes[fieldType]; fieldType.Kind() != reflect.Struct || ok {
+				continue
+			}
+
+			if err := jsonTypeParams(fieldType, path, params); err != nil {
+				return err
+			}
+		case jsonSkipType:
+			*params = append(*params, "SKIP "+quoteJSONPath(path))
+		default:
+			if _, err := Type(chType).Column("", &ServerContext{}); err != nil {
+				return fmt.Errorf("invalid chType \"%s\" for path \"%s\": %w", chType, path, err)
+			}
+
+			*params = append(*params, quoteJSONPath(path)+" "+chType)
+		}
+	}
+
+	return nil
+}
+
+// quoteJSONPath quotes paths containing characters other than letters, digits, underscores and dots.
+func quoteJSONPath(path string) string {
+	for _, r := range path {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '.':
+		default:
+			return "`" + strings.ReplaceAll(path, "`", "\\`") + "`"
+		}
+	}
+
+	return path
+}
+
+// StrictJSON wraps a pointer to a struct for scanning a JSON column. Scanning fails if the row has a non-NULL
+// dynamic path that is not mapped to a field of the struct. Map fields accept any path below them.
+func StrictJSON(dest any) any {
+	return &strictJSON{dest: dest}
+}
+
+type strictJSON struct {
+	dest any
+}
+
+// unexpectedDynamicPaths returns an error listing the non-NULL dynamic paths of row that are not mapped by dest.
+func (c *JSON) unexpectedDynamicPaths(dest any, row int) error {
+	typ := reflect.TypeOf(dest)
+	if typ == nil || typ.Kind() != reflect.Pointer || typ.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("strict JSON destination must be a pointer to struct, got %T", dest)
+	}
+
+	paths := make(map[string]struct{})
+	var prefixes []string
+	collectJSONPaths(typ.Elem(), "", paths, &prefixes)
+
+	var unexpected []string
+	for i, path := range c.dynamicPaths {
+		if _, ok := paths[path]; ok || hasJSONPathPrefix(path, prefixes) {
+			continue
+		}
+
+		if c.dynamicColumns[i].Row(row, false).(chcol.Dynamic).Nil() {
+			continue
+		}
+
+		unexpected = append(unexpected, path)
+	}
+
+	if len(unexpected) != 0 {
+		sort.Strings(unexpected)
+		return fmt.Errorf("unexpected dynamic paths in JSON column: %s", strings.Join(unexpected, ", "))
+	}
+
+	return nil
+}
+
+// collectJSONPaths collects the paths mapped by the fields of typ, and the paths of map fields which accept any nested path.
+func collectJSONPaths(typ reflect.Type, prefix string, paths map[string]struct{}, prefixes *[]string) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+
+		path := joinJSONPath(prefix, name)
+		paths[path] = struct{}{}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		switch fieldType.Kind() {
+		case reflect.Struct:
+			if _, ok := iterateStructSkipTypes[fieldType]; !ok {
+				collectJSONPaths(fieldType, path, paths, prefixes)
+			}
+		case reflect.Map:
+			*prefixes = append(*prefixes, path)
+		}
+	}
+}
+
+func hasJSONPathPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix+".") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,137 @@
+package column
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ClickHouse/ch-go/proto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeDecodeJSON(t *testing.T, col Interface) Interface {
+	var buffer proto.Buffer
+	require.NoError(t, col.(CustomSerialization).WriteStatePrefix(&buffer))
+	col.Encode(&buffer)
+
+	decoded, err := col.Type().Column(col.Name(), &ServerContext{})
+	require.NoError(t, err)
+	reader := proto.NewReader(buffer.Reader())
+	require.NoError(t, decoded.(CustomSerialization).ReadStatePrefix(reader))
+	require.NoError(t, decoded.Decode(reader, col.Rows()))
+	return decoded
+}
+
+type jsonSchemaEvent struct {
+	ID      uint32            `json:"event.id" chType:"UInt32"`
+	Name    string            `json:"event.name" chType:"String"`
+	Debug   string            `json:"debug" chType:"SKIP"`
+	Source  jsonSchemaSource  `json:"source"`
+	Payload string            `json:"payload"`
+	Labels  map[string]string `json:"labels"`
+}
+
+type jsonSchemaSource struct {
+	Host string `json:"host" chType:"String"`
+	Port int64  `json:"port"`
+}
+
+func TestJSONTypeOf(t *testing.T) {
+	chType, err := JSONTypeOf(&jsonSchemaEvent{})
+	require.NoError(t, err)
+	assert.Equal(t, Type("JSON(event.id UInt32, event.name String, SKIP debug, source.host String)"), chType)
+
+	chType, err = JSONTypeOf(struct {
+		Value string `json:"a-b" chType:"String"`
+	}{})
+	require.NoError(t, err)
+	assert.Equal(t, Type("JSON(`a-b` String)"), chType)
+
+	chType, err = JSONTypeOf(struct{ Value string }{})
+	require.NoError(t, err)
+	assert.Equal(t, Type("JSON"), chType)
+
+	chType, err = JSONTypeOf(struct {
+		A time.Time            `json:"a" chType:"DateTime"`
+		B time.Time            `json:"b" chType:"DateTime64(3)"`
+		C time.Time            `json:"c" chType:"Date"`
+		D any                  `json:"d" chType:"Dynamic"`
+		E *time.Time           `json:"e" chType:"Nullable(DateTime)"`
+		F []time.Time          `json:"f" chType:"Array(DateTime)"`
+		G map[string]time.Time `json:"g" chType:"Map(String, DateTime)"`
+	}{})
+	require.NoError(t, err)
+	assert.Equal(t, Type("JSON(a DateTime, b DateTime64(3), c Date, d Dynamic, e Nullable(DateTime), f Array(DateTime), g Map(String, DateTime))"), chType)
+
+	_, err = JSONTypeOf(struct {
+		Value string `chType:"NotAType"`
+	}{})
+	assert.Error(t, err)
+	_, err = JSONTypeOf("string")
+	assert.Error(t, err)
+
+	// the declaration can be parsed back into a column
+	_, err = Type("JSON(event.id UInt32, SKIP debug)").Column("event", &ServerContext{})
+	require.NoError(t, err)
+}
+
+func TestJSON_PathTags(t *testing.T) {
+	chType, err := JSONTypeOf(&jsonSchemaEvent{})
+	require.NoError(t, err)
+	col, err := chType.Column("event", &ServerContext{})
+	require.NoError(t, err)
+
+	require.NoError(t, col.AppendRow(&jsonSchemaEvent{
+		ID:      42,
+		Name:    "click",
+		Debug:   "not stored",
+		Source:  jsonSchemaSource{Host: "localhost", Port: 9000},
+		Payload: "data",
+		Labels:  map[string]string{"env": "prod"},
+	}))
+	require.Equal(t, 1, col.Rows())
+	col = encodeDecodeJSON(t, col)
+
+	var event jsonSchemaEvent
+	require.NoError(t, col.ScanRow(&event, 0))
+	assert.Equal(t, jsonSchemaEvent{
+		ID:      42,
+		Name:    "click",
+		Source:  jsonSchemaSource{Host: "localhost", Port: 9000},
+		Payload: "data",
+		Labels:  map[string]string{"env": "prod"},
+	}, event)
+	assert.False(t, col.(*JSON).hasDynamicPath("debug"))
+}
+
+func TestJSON_StrictScan(t *testing.T) {
+	col, err := Type("JSON(event.id UInt32)").Column("event", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow(map[string]any{
+		"event":  map[string]any{"id": uint32(1)},
+		"labels": map[string]any{"env": "prod"},
+	}))
+	require.NoError(t, col.AppendRow(map[string]any{
+		"event": map[string]any{"id": uint32(2), "extra": "unexpected"},
+		"other": int64(1),
+	}))
+	col = encodeDecodeJSON(t, col)
+
+	type event struct {
+		ID     uint32            `json:"event.id"`
+		Labels map[string]string `json:"labels"`
+	}
+	var dest event
+	require.NoError(t, col.ScanRow(StrictJSON(&dest), 0))
+	assert.Equal(t, event{ID: 1, Labels: map[string]string{"env": "prod"}}, dest)
+
+	dest = event{}
+	err = col.ScanRow(StrictJSON(&dest), 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "event.extra, other")
+
+	// without strict mode unexpected paths are ignored
+	require.NoError(t, col.ScanRow(&dest, 1))
+	assert.Equal(t, uint32(2), dest.ID)
+}
@@ -0,0 +1,55 @@
+package column
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
+)
+
+func TestJSON_WriteRowJSON(t *testing.T) {
+	col, err := Type("JSON(id UInt32, meta.source String)").Column("event", &ServerContext{})
+	require.NoError(t, err)
+
+	obj := chcol.NewJSON()
+	obj.SetValueAtPath("id", uint32(1))
+	obj.SetValueAtPath("meta.source", "api")
+	obj.SetValueAtPath("tags", []string{"a", "b"})
+	obj.SetValueAtPath("meta.count", int64(3))
+	require.NoError(t, col.AppendRow(obj))
+	// raw JSON is parsed into paths without intermediate maps once the object serialization is in use
+	require.NoError(t, col.AppendRow(json.RawMessage(`{"id":2,"meta":{"source":"cli"},"extra":{"flag":true}}`)))
+	col = encodeDecodeJSON(t, col)
+
+	var buf bytes.Buffer
+	require.NoError(t, col.(*JSON).WriteRowJSON(&buf, 0))
+	assert.Equal(t, `{"id":1,"meta":{"count":3,"source":"api"},"tags":["a","b"]}`, buf.String())
+
+	var raw json.RawMessage
+	require.NoError(t, col.ScanRow(&raw, 1))
+	assert.Equal(t, `{"extra":{"flag":true},"id":2,"meta":{"source":"cli"}}`, string(raw))
+
+	// the streamed output matches encoding/json of the scanned value
+	var scanned chcol.JSON
+	require.NoError(t, col.ScanRow(&scanned, 0))
+	expected, err := json.Marshal(&scanned)
+	require.NoError(t, err)
+	buf.Reset()
+	require.NoError(t, col.ScanRow(&buf, 0))
+	assert.Equal(t, string(expected), buf.String())
+}
+
+func TestJSON_WriteRowJSONString(t *testing.T) {
+	col, err := Type("JSON").Column("event", &ServerContext{})
+	require.NoError(t, err)
+	_, err = col.Append([]json.RawMessage{json.RawMessage(`{"b":1,"a":2}`)})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, col.(*JSON).WriteRowJSON(&buf, 0))
+	assert.Equal(t, `{"b":1,"a":2}`, buf.String())
+}
@@ -31,6 +31,15 @@ const (
 
 const sharedDictionariesWithAdditionalKeys = 1
 
+// maxReusedDictionaryRows is the size above which a dictionary kept by ReuseDictionary is rebuilt on Reset,
+// as every block carries the whole dictionary.
+const maxReusedDictionaryRows = 1 << 14
+
+// LowCardinalityKey is the position of a LowCardinality value in the dictionary of the block it was read from.
+// Scanning into it groups rows without materialising their values. Keys are only comparable within a block;
+// for LowCardinality(Nullable(T)) key 0 is NULL.
+type LowCardinalityKey int
+
 // https://github.com/ClickHouse/ClickHouse/blob/master/src/Columns/ColumnLowCardinality.cpp
 // https://github.com/ClickHouse/clickhouse-cpp/blob/master/clickhouse/columns/lowcardinality.cpp
 type LowCardinality struct {
@@ -39,6 +48,13 @@ type LowCardinality struct {
 	index    Interface
 	chType   Type
 	nullable bool
+	// reuse keeps the dictionary across Reset, so consecutive blocks of a batch don't rebuild it
+	reuse bool
+	// seed holds the values given to SetDictionary, to restore them when a reused dictionary grows too large
+	seed struct {
+		values any
+		rows   int
+	}
 
 	keys8  UInt8
 	keys16 UInt16
@@ -54,13 +70,68 @@ type LowCardinality struct {
 
 func (col *LowCardinality) Reset() {
 	col.rows = 0
-	col.index.Reset()
 	col.keys8.Reset()
 	col.keys16.Reset()
 	col.keys32.Reset()
 	col.keys64.Reset()
-	col.append.index = make(map[any]int)
 	col.append.keys = col.append.keys[:0]
+	if col.reuse && col.append.index != nil && col.index.Rows() <= max(maxReusedDictionaryRows, col.seed.rows) {
+		return
+	}
+	col.index.Reset()
+	col.append.index = make(map[any]int)
+	if col.seed.values != nil {
+		// the values were checked by SetDictionary
+		_ = col.appendDictionary(col.seed.values)
+	}
+}
+
+// ReuseDictionary keeps the dictionary built while appending across Reset, so values seen in previous blocks
+// don't have to be hashed and copied into the dictionary again. Every block still carries the whole dictionary
+// as the native format requires, which suits columns with few distinct values: once the dictionary has more
+// than 16384 values, it is rebuilt from the values of the next block (and those given to SetDictionary).
+func (col *LowCardinality) ReuseDictionary() {
+	col.reuse = true
+}
+
+// SetDictionary pre-seeds the dictionary with a slice of values and keeps it across Reset like ReuseDictionary.
+// The seeded values stay in the dictionary when it is rebuilt.
+func (col *LowCardinality) SetDictionary(values any) error {
+	if err := col.appendDictionary(values); err != nil {
+		return err
+	}
+	col.reuse = true
+	col.seed.values, col.seed.rows = values, col.index.Rows()
+	return nil
+}
+
+// appendDictionary adds a slice of values to the dictionary.
+func (col *LowCardinality) appendDictionary(values any) error {
+	value := reflect.Indirect(reflect.ValueOf(values))
+	if value.Kind() != reflect.Slice {
+		return &ColumnConverterError{
+			Op:   "SetDictionary",
+			To:   string(col.chType),
+			From: fmt.Sprintf("%T", values),
+			Hint: "dictionary values must be a slice",
+		}
+	}
+	for i := 0; i < value.Len(); i++ {
+		if _, err := col.dictionaryKey(value.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Dictionary returns the column holding the distinct values referenced by Key.
+func (col *LowCardinality) Dictionary() Interface {
+	return col.index
+}
+
+// Key returns the position of the value at row i in Dictionary.
+func (col *LowCardinality) Key(i int) int {
+	return col.indexRowNum(i)
 }
 
 func (col *LowCardinality) Name() string {
@@ -101,7 +172,19 @@ func (col *LowCardinality) Row(i int, ptr bool) any {
 
 func (col *LowCardinality) ScanRow(dest any, row int) error {
 	idx := col.indexRowNum(row)
+	switch d := dest.(type) {
+	case *LowCardinalityKey:
+		*d = LowCardinalityKey(idx)
+		return nil
+	case **LowCardinalityKey:
+		*d = new(LowCardinalityKey)
+		**d = LowCardinalityKey(idx)
+		return nil
+	}
 	if idx == 0 && col.nullable {
+		if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+			return unmarshaler.UnmarshalColumn(nil)
+		}
 		return nil
 	}
 	return col.index.ScanRow(dest, idx)
@@ -125,29 +208,47 @@ func (col *LowCardinality) Append(v any) (nulls []uint8, err error) {
 }
 
 func (col *LowCardinality) AppendRow(v any) error {
+	key, err := col.dictionaryKey(v)
+	if err != nil {
+		return err
+	}
 	col.rows++
+	col.append.keys = append(col.append.keys, key)
+	return nil
+}
+
+// dictionaryKey returns the position of v in the dictionary, adding it if it is not there yet.
+func (col *LowCardinality) dictionaryKey(v any) (int, error) {
 	if col.index.Rows() == 0 { // init
 		if col.index.AppendRow(nil); col.nullable {
 			col.index.AppendRow(nil)
 		}
 	}
+	// marshal custom types first so that the dictionary is keyed by the base value
+	if marshaler, ok := v.(ColumnMarshaler); ok {
+		val, err := marshalColumn(col, "AppendRow", marshaler)
+		if err != nil {
+			return 0, err
+		}
+		v = val
+	}
 	// second check is unfortunate - but we could be passed a *type(nil) e.g. via LowCardinality(Nullable(String))
 	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil()) {
-		col.append.keys = append(col.append.keys, 0)
-		return nil
+		return 0, nil
 	}
 	switch x := v.(type) {
 	case time.Time:
 		v = x.Truncate(time.Second)
 	}
-	if _, found := col.append.index[v]; !found {
-		if err := col.index.AppendRow(v); err != nil {
-			return err
-		}
-		col.append.index[v] = col.index.Rows() - 1
+	if key, found := col.append.index[v]; found {
+		return key, nil
 	}
-	col.append.keys = append(col.append.keys, col.append.index[v])
-	return nil
+	if err := col.index.AppendRow(v); err != nil {
+		return 0, err
+	}
+	key := col.index.Rows() - 1
+	col.append.index[v] = key
+	return key, nil
 }
 
 func (col *LowCardinality) Decode(reader *proto.Reader, rows int) error {
@@ -199,7 +300,9 @@ func (col *LowCardinality) Encode(buffer *proto.Buffer) {
 		return
 	}
 	defer func() {
-		col.append.keys, col.append.index = nil, nil
+		if col.append.keys = nil; !col.reuse {
+			col.append.index = nil
+		}
 	}()
 	ixLen := uint64(len(col.append.index))
 	switch {
@@ -0,0 +1,132 @@
+package column
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeDecodeLowCardinality(t *testing.T, col Interface) *LowCardinality {
+	var buffer proto.Buffer
+	require.NoError(t, col.(CustomSerialization).WriteStatePrefix(&buffer))
+	col.Encode(&buffer)
+
+	decoded, err := col.Type().Column(col.Name(), &ServerContext{})
+	require.NoError(t, err)
+	reader := proto.NewReader(buffer.Reader())
+	require.NoError(t, decoded.(CustomSerialization).ReadStatePrefix(reader))
+	require.NoError(t, decoded.Decode(reader, col.Rows()))
+	return decoded.(*LowCardinality)
+}
+
+func TestLowCardinality_Keys(t *testing.T) {
+	col, err := Type("LowCardinality(String)").Column("lc", &ServerContext{})
+	require.NoError(t, err)
+	_, err = col.Append([]string{"info", "warn", "info", "error", "warn"})
+	require.NoError(t, err)
+
+	decoded := encodeDecodeLowCardinality(t, col)
+	var keys []LowCardinalityKey
+	for i := 0; i < decoded.Rows(); i++ {
+		var key LowCardinalityKey
+		require.NoError(t, decoded.ScanRow(&key, i))
+		keys = append(keys, key)
+		assert.Equal(t, int(key), decoded.Key(i))
+	}
+	assert.Equal(t, keys[0], keys[2])
+	assert.Equal(t, keys[1], keys[4])
+	assert.NotEqual(t, keys[0], keys[3])
+	assert.Equal(t, "error", decoded.Dictionary().Row(int(keys[3]), false))
+}
+
+func TestLowCardinality_NullableKeys(t *testing.T) {
+	col, err := Type("LowCardinality(Nullable(String))").Column("lc", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow(nil))
+	require.NoError(t, col.AppendRow("a"))
+
+	decoded := encodeDecodeLowCardinality(t, col)
+	var key LowCardinalityKey
+	require.NoError(t, decoded.ScanRow(&key, 0))
+	assert.Equal(t, LowCardinalityKey(0), key)
+	require.NoError(t, decoded.ScanRow(&key, 1))
+	assert.NotEqual(t, LowCardinalityKey(0), key)
+}
+
+func TestLowCardinality_SetDictionary(t *testing.T) {
+	col, err := Type("LowCardinality(String)").Column("lc", &ServerContext{})
+	require.NoError(t, err)
+	lc := col.(*LowCardinality)
+	require.NoError(t, lc.SetDictionary([]string{"debug", "info", "warn", "error"}))
+	dictionary := lc.Dictionary().Rows()
+
+	for block := 0; block < 3; block++ {
+		_, err = col.Append([]string{"warn", "info"})
+		require.NoError(t, err)
+		// seeded values don't grow the dictionary
+		assert.Equal(t, dictionary, lc.Dictionary().Rows())
+
+		decoded := encodeDecodeLowCardinality(t, col)
+		assert.Equal(t, 2, decoded.Rows())
+		assert.Equal(t, "warn", decoded.Row(0, false))
+		assert.Equal(t, "info", decoded.Row(1, false))
+		col.Reset()
+	}
+
+	var converterErr *ColumnConverterError
+	require.ErrorAs(t, lc.SetDictionary("debug"), &converterErr)
+}
+
+func TestLowCardinality_ReuseDictionary(t *testing.T) {
+	col, err := Type("LowCardinality(String)").Column("lc", &ServerContext{})
+	require.NoError(t, err)
+	lc := col.(*LowCardinality)
+	lc.ReuseDictionary()
+
+	require.NoError(t, col.AppendRow("a"))
+	encodeDecodeLowCardinality(t, col)
+	col.Reset()
+	assert.Equal(t, 0, col.Rows())
+	dictionary := lc.Dictionary().Rows()
+
+	require.NoError(t, col.AppendRow("b"))
+	require.NoError(t, col.AppendRow("a"))
+	assert.Equal(t, dictionary+1, lc.Dictionary().Rows())
+	decoded := encodeDecodeLowCardinality(t, col)
+	assert.Equal(t, "b", decoded.Row(0, false))
+	assert.Equal(t, "a", decoded.Row(1, false))
+
+	// without reuse the dictionary starts over
+	col, err = Type("LowCardinality(String)").Column("lc", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow("a"))
+	col.Reset()
+	assert.Equal(t, 0, col.(*LowCardinality).Dictionary().Rows())
+}
+
+func TestLowCardinality_ReuseDictionaryLimit(t *testing.T) {
+	col, err := Type("LowCardinality(String)").Column("lc", &ServerContext{})
+	require.NoError(t, err)
+	lc := col.(*LowCardinality)
+	require.NoError(t, lc.SetDictionary([]string{"seeded"}))
+	seeded := lc.Dictionary().Rows()
+
+	values := make([]string, maxReusedDictionaryRows)
+	for i := range values {
+		values[i] = strconv.Itoa(i)
+	}
+	_, err = col.Append(values)
+	require.NoError(t, err)
+	require.Greater(t, lc.Dictionary().Rows(), maxReusedDictionaryRows)
+
+	// the dictionary is too large to be kept and starts over from the seeded values
+	col.Reset()
+	assert.Equal(t, seeded, lc.Dictionary().Rows())
+	require.NoError(t, col.AppendRow("seeded"))
+	assert.Equal(t, seeded, lc.Dictionary().Rows())
+	decoded := encodeDecodeLowCardinality(t, col)
+	assert.Equal(t, "seeded", decoded.Row(0, false))
+}
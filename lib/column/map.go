@@ -17,6 +17,12 @@ type Map struct {
 	offsets  Int64
 	scanType reflect.Type
 	name     string
+	sc       *ServerContext
+	// scratch columns used by appendPairs to check a row before appending it
+	scratch struct {
+		keys   Interface
+		values Interface
+	}
 }
 
 type OrderedMap interface {
@@ -47,7 +53,7 @@ func (col *Map) Name() string {
 }
 
 func (col *Map) parse(t Type, sc *ServerContext) (_ Interface, err error) {
-	col.chType = t
+	col.chType, col.sc = t, sc
 	types := make([]string, 2, 2)
 	typeParams := t.params()
 	idx := strings.Index(typeParams, ",")
@@ -96,6 +102,9 @@ func (col *Map) Row(i int, ptr bool) any {
 }
 
 func (col *Map) ScanRow(dest any, i int) error {
+	if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+		return unmarshaler.UnmarshalColumn(col.row(i).Interface())
+	}
 	if scanner, ok := dest.(sql.Scanner); ok {
 		return scanner.Scan(col.row(i).Interface())
 	}
@@ -118,6 +127,9 @@ func (col *Map) ScanRow(dest any, i int) error {
 		}
 		return nil
 	}
+	if value.Kind() == reflect.Map {
+		return col.scanConvertedRow(value, i)
+	}
 	return &ColumnConverterError{
 		Op:   "ScanRow",
 		To:   fmt.Sprintf("%T", dest),
@@ -157,6 +169,13 @@ func (col *Map) Append(v any) (nulls []uint8, err error) {
 }
 
 func (col *Map) AppendRow(v any) error {
+	if marshaler, ok := v.(ColumnMarshaler); ok {
+		val, err := marshalColumn(col, "AppendRow", marshaler)
+		if err != nil {
+			return err
+		}
+		return col.AppendRow(val)
+	}
 	if v == nil {
 		// NOTE: successful Map.parse() make sure we have
 		// valid col.scanType
@@ -243,6 +262,17 @@ func (col *Map) AppendRow(v any) error {
 		return col.AppendRow(val)
 	}
 
+	// maps with other key or value types, e.g. map[string]Money where Money implements ColumnMarshaler
+	if value.Kind() == reflect.Map {
+		keys, values := make([]any, 0, value.Len()), make([]any, 0, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			keys = append(keys, iter.Key().Interface())
+			values = append(values, iter.Value().Interface())
+		}
+		return col.appendPairs(keys, values)
+	}
+
 	return &ColumnConverterError{
 		Op:   "AppendRow",
 		To:   string(col.chType),
@@ -331,6 +361,87 @@ func (col *Map) row(n int) reflect.Value {
 	return value
 }
 
+// scanConvertedRow scans row n into a map with key or value types that differ from the column scan type,
+// e.g. map[string]Money where Money implements ColumnUnmarshaler.
+func (col *Map) scanConvertedRow(dest reflect.Value, n int) error {
+	keys, values := col.orderedRow(n)
+	m := reflect.MakeMapWithSize(dest.Type(), len(keys))
+	for i := range keys {
+		key := reflect.New(dest.Type().Key()).Elem()
+		if err := setMapElemValue(key, keys[i]); err != nil {
+			return err
+		}
+		value := reflect.New(dest.Type().Elem()).Elem()
+		if err := setMapElemValue(value, values[i]); err != nil {
+			return err
+		}
+		m.SetMapIndex(key, value)
+	}
+	dest.Set(m)
+	return nil
+}
+
+func setMapElemValue(field reflect.Value, v any) error {
+	if v == nil {
+		if ok, err := unmarshalColumnValue(field, nil); ok {
+			return err
+		}
+		return nil
+	}
+	return setJSONFieldValue(field, reflect.ValueOf(v))
+}
+
+// appendPairs appends the keys and values of a single row of a map type other than the column scan type.
+// They are appended to scratch columns of the same types first, so that a key or value failing to convert
+// part way through, e.g. a ColumnMarshaler returning an error, leaves the column untouched.
+func (col *Map) appendPairs(keys, values []any) error {
+	if col.scratch.keys == nil {
+		var err error
+		if col.scratch.keys, err = col.keys.Type().Column(col.name, col.sc); err != nil {
+			return err
+		}
+		if col.scratch.values, err = col.values.Type().Column(col.name, col.sc); err != nil {
+			return err
+		}
+	}
+	col.scratch.keys.Reset()
+	col.scratch.values.Reset()
+	for i := range keys {
+		// marshal custom types once so that MarshalColumn is not called again for the second append
+		var err error
+		if marshaler, ok := keys[i].(ColumnMarshaler); ok {
+			if keys[i], err = marshalColumn(col.keys, "AppendRow", marshaler); err != nil {
+				return err
+			}
+		}
+		if marshaler, ok := values[i].(ColumnMarshaler); ok {
+			if values[i], err = marshalColumn(col.values, "AppendRow", marshaler); err != nil {
+				return err
+			}
+		}
+		if err := col.scratch.keys.AppendRow(keys[i]); err != nil {
+			return err
+		}
+		if err := col.scratch.values.AppendRow(values[i]); err != nil {
+			return err
+		}
+	}
+	for i := range keys {
+		if err := col.keys.AppendRow(keys[i]); err != nil {
+			return err
+		}
+		if err := col.values.AppendRow(values[i]); err != nil {
+			return err
+		}
+	}
+	var prev int64
+	if n := col.offsets.Rows(); n != 0 {
+		prev = col.offsets.col.Row(n - 1)
+	}
+	col.offsets.col.Append(prev + int64(len(keys)))
+	return nil
+}
+
 func (col *Map) orderedRow(n int) ([]any, []any) {
 	var prev int64
 	if n != 0 {
@@ -0,0 +1,95 @@
+package column
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ColumnMarshaler is implemented by user defined types that can convert themselves into a Go value
+// natively supported by a column, e.g. a Money type returning a decimal.Decimal or a TenantID returning a string.
+// Returning a nil value appends NULL to Nullable columns. The returned value must not implement ColumnMarshaler itself.
+type ColumnMarshaler interface {
+	MarshalColumn() (any, error)
+}
+
+// ColumnUnmarshaler is implemented by user defined types that can populate themselves from the base Go value
+// of a column row, e.g. a string for String columns. v is nil when the row is NULL.
+type ColumnUnmarshaler interface {
+	UnmarshalColumn(v any) error
+}
+
+var (
+	columnMarshalerType   = reflect.TypeOf((*ColumnMarshaler)(nil)).Elem()
+	columnUnmarshalerType = reflect.TypeOf((*ColumnUnmarshaler)(nil)).Elem()
+)
+
+// marshalColumn converts v into the base value expected by col.
+func marshalColumn(col Interface, op string, v ColumnMarshaler) (any, error) {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil, nil
+	}
+	val, err := v.MarshalColumn()
+	if err != nil {
+		return nil, &ColumnConverterError{
+			Op:   op,
+			To:   string(col.Type()),
+			From: fmt.Sprintf("%T", v),
+			Hint: fmt.Sprintf("could not get ColumnMarshaler value: %s", err),
+		}
+	}
+	if _, ok := val.(ColumnMarshaler); ok {
+		// appending a value that marshals itself again would never terminate
+		return nil, &ColumnConverterError{
+			Op:   op,
+			To:   string(col.Type()),
+			From: fmt.Sprintf("%T", v),
+			Hint: fmt.Sprintf("MarshalColumn returned %T which implements ColumnMarshaler, return a base value instead", val),
+		}
+	}
+	return val, nil
+}
+
+// appendColumnMarshalers appends v row by row if v is a slice of ColumnMarshaler values.
+// ok is false when v is not such a slice.
+func appendColumnMarshalers(col Interface, v any) (nulls []uint8, ok bool, err error) {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice || !value.Type().Elem().Implements(columnMarshalerType) {
+		return nil, false, nil
+	}
+	nulls = make([]uint8, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		elem := value.Index(i)
+		if (elem.Kind() == reflect.Pointer || elem.Kind() == reflect.Interface) && elem.IsNil() {
+			nulls[i] = 1
+			if err := col.AppendRow(nil); err != nil {
+				return nil, true, err
+			}
+			continue
+		}
+		val, err := marshalColumn(col, "Append", elem.Interface().(ColumnMarshaler))
+		if err != nil {
+			return nil, true, err
+		}
+		if val == nil {
+			nulls[i] = 1
+		}
+		if err := col.AppendRow(val); err != nil {
+			return nil, true, err
+		}
+	}
+	return nulls, true, nil
+}
+
+// unmarshalColumnValue sets field from value if field (or its address) implements ColumnUnmarshaler.
+func unmarshalColumnValue(field reflect.Value, value any) (bool, error) {
+	switch {
+	case field.Kind() == reflect.Pointer && field.Type().Implements(columnUnmarshalerType):
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		return true, field.Interface().(ColumnUnmarshaler).UnmarshalColumn(value)
+	case field.CanAddr() && field.Addr().Type().Implements(columnUnmarshalerType):
+		return true, field.Addr().Interface().(ColumnUnmarshaler).UnmarshalColumn(value)
+	}
+	return false, nil
+}
@@ -0,0 +1,276 @@
+package column
+
+import (
+	"database/sql/driver"
+	"errors"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testMoney int64
+
+func (m testMoney) MarshalColumn() (any, error) {
+	return int64(m), nil
+}
+
+func (m *testMoney) UnmarshalColumn(v any) error {
+	switch v := v.(type) {
+	case int64:
+		*m = testMoney(v)
+	case nil:
+		*m = -1
+	default:
+		return errors.New("unexpected value")
+	}
+	return nil
+}
+
+type testTenantID struct {
+	id string
+}
+
+func (t testTenantID) MarshalColumn() (any, error) {
+	if t.id == "" {
+		return nil, nil
+	}
+	return "tenant-" + t.id, nil
+}
+
+func (t *testTenantID) UnmarshalColumn(v any) error {
+	if s, ok := v.(string); ok {
+		t.id = s[len("tenant-"):]
+	}
+	return nil
+}
+
+func newTestColumn(t *testing.T, typ Type) Interface {
+	col, err := typ.Column("test", &ServerContext{})
+	require.NoError(t, err)
+	return col
+}
+
+func TestColumnMarshaler_Int64(t *testing.T) {
+	col := newTestColumn(t, "Int64")
+	require.NoError(t, col.AppendRow(testMoney(42)))
+	_, err := col.Append([]testMoney{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, 3, col.Rows())
+
+	var m testMoney
+	require.NoError(t, col.ScanRow(&m, 0))
+	assert.Equal(t, testMoney(42), m)
+	require.NoError(t, col.ScanRow(&m, 2))
+	assert.Equal(t, testMoney(2), m)
+}
+
+func TestColumnMarshaler_Nullable(t *testing.T) {
+	col := newTestColumn(t, "Nullable(String)")
+	require.NoError(t, col.AppendRow(testTenantID{id: "a"}))
+	require.NoError(t, col.AppendRow(testTenantID{}))
+	require.NoError(t, col.AppendRow((*testTenantID)(nil)))
+
+	var id testTenantID
+	require.NoError(t, col.ScanRow(&id, 0))
+	assert.Equal(t, "a", id.id)
+	assert.Nil(t, col.Row(1, false))
+	assert.Nil(t, col.Row(2, false))
+
+	ints := newTestColumn(t, "Nullable(Int64)")
+	require.NoError(t, ints.AppendRow(nil))
+	var m testMoney
+	require.NoError(t, ints.ScanRow(&m, 0))
+	assert.Equal(t, testMoney(-1), m)
+}
+
+// testBoth implements both ColumnUnmarshaler and encoding.BinaryUnmarshaler and records which one was used.
+type testBoth struct {
+	via string
+}
+
+func (b *testBoth) UnmarshalColumn(any) error {
+	b.via = "column"
+	return nil
+}
+
+func (b *testBoth) UnmarshalBinary([]byte) error {
+	b.via = "binary"
+	return nil
+}
+
+func TestColumnUnmarshaler_BeforeBinaryUnmarshaler(t *testing.T) {
+	for _, typ := range []Type{"String", "FixedString(3)"} {
+		col := newTestColumn(t, typ)
+		require.NoError(t, col.AppendRow("abc"))
+
+		var dest testBoth
+		require.NoError(t, col.ScanRow(&dest, 0))
+		assert.Equal(t, "column", dest.via, typ)
+	}
+}
+
+func TestColumnMarshaler_Array(t *testing.T) {
+	col := newTestColumn(t, "Array(Int64)")
+	require.NoError(t, col.AppendRow([]testMoney{1, 2, 3}))
+
+	var dest []testMoney
+	require.NoError(t, col.ScanRow(&dest, 0))
+	assert.Equal(t, []testMoney{1, 2, 3}, dest)
+}
+
+func TestColumnMarshaler_Map(t *testing.T) {
+	col := newTestColumn(t, "Map(String, Int64)")
+	require.NoError(t, col.AppendRow(map[testTenantID]testMoney{{id: "a"}: 10}))
+
+	var dest map[testTenantID]testMoney
+	require.NoError(t, col.ScanRow(&dest, 0))
+	assert.Equal(t, map[testTenantID]testMoney{{id: "a"}: 10}, dest)
+}
+
+func TestColumnMarshaler_Tuple(t *testing.T) {
+	type row struct {
+		Tenant testTenantID `ch:"tenant"`
+		Amount testMoney    `ch:"amount"`
+	}
+	col := newTestColumn(t, "Tuple(tenant String, amount Int64)")
+	require.NoError(t, col.AppendRow(row{Tenant: testTenantID{id: "b"}, Amount: 7}))
+
+	var dest row
+	require.NoError(t, col.ScanRow(&dest, 0))
+	assert.Equal(t, row{Tenant: testTenantID{id: "b"}, Amount: 7}, dest)
+}
+
+type testMoneyErr struct{}
+
+func (testMoneyErr) MarshalColumn() (any, error) {
+	return nil, errors.New("boom")
+}
+
+func TestColumnMarshaler_Error(t *testing.T) {
+	col := newTestColumn(t, "Int64")
+	err := col.AppendRow(testMoneyErr{})
+	var converterErr *ColumnConverterError
+	require.ErrorAs(t, err, &converterErr)
+	assert.Equal(t, "AppendRow", converterErr.Op)
+}
+
+// testWrapped marshals to and unmarshals from whatever base value it holds.
+type testWrapped struct {
+	v any
+}
+
+func (w testWrapped) MarshalColumn() (any, error) {
+	return w.v, nil
+}
+
+func (w *testWrapped) UnmarshalColumn(v any) error {
+	w.v = v
+	return nil
+}
+
+func TestColumnMarshaler_Types(t *testing.T) {
+	tests := []struct {
+		typ   Type
+		value any
+	}{
+		{"Decimal(10, 2)", decimal.RequireFromString("12.34")},
+		{"FixedString(3)", "abc"},
+		{"UUID", uuid.MustParse("8b7c5a7e-3d52-4b43-8a87-1d1fe6a1f0c2")},
+		{"Date", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"DateTime('UTC')", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{"DateTime64(3, 'UTC')", time.Date(2024, 1, 2, 3, 4, 5, 6e6, time.UTC)},
+		{"Enum8('a' = 1, 'b' = 2)", "b"},
+		{"Enum16('a' = 1, 'b' = 2)", "a"},
+		{"LowCardinality(String)", "low"},
+		{"IPv4", net.ParseIP("127.0.0.1").To4()},
+		{"IPv6", net.ParseIP("::1")},
+		{"Bool", true},
+		{"Int128", big.NewInt(-7)},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.typ), func(t *testing.T) {
+			col := newTestColumn(t, tt.typ)
+			require.NoError(t, col.AppendRow(testWrapped{v: tt.value}))
+			_, err := col.Append([]testWrapped{{v: tt.value}})
+			require.NoError(t, err)
+			require.Equal(t, 2, col.Rows())
+			if _, ok := col.(*LowCardinality); ok {
+				col = encodeDecodeLowCardinality(t, col)
+			}
+
+			for row := 0; row < col.Rows(); row++ {
+				var dest testWrapped
+				require.NoError(t, col.ScanRow(&dest, row))
+				assert.Equal(t, col.Row(row, false), dest.v)
+			}
+		})
+	}
+}
+
+func TestColumnMarshaler_LowCardinalityNull(t *testing.T) {
+	col := newTestColumn(t, "LowCardinality(Nullable(String))")
+	require.NoError(t, col.AppendRow(testTenantID{}))
+	require.NoError(t, col.AppendRow(testTenantID{id: "a"}))
+	col = encodeDecodeLowCardinality(t, col)
+
+	dest := testWrapped{v: "not null"}
+	require.NoError(t, col.ScanRow(&dest, 0))
+	assert.Nil(t, dest.v)
+	require.NoError(t, col.ScanRow(&dest, 1))
+	assert.Equal(t, "tenant-a", dest.v)
+}
+
+type testSelfMarshaler struct{}
+
+func (m testSelfMarshaler) MarshalColumn() (any, error) {
+	return m, nil
+}
+
+func TestColumnMarshaler_ReturnsMarshaler(t *testing.T) {
+	col := newTestColumn(t, "String")
+	err := col.AppendRow(testSelfMarshaler{})
+	var converterErr *ColumnConverterError
+	require.ErrorAs(t, err, &converterErr)
+	assert.Equal(t, 0, col.Rows())
+}
+
+func TestColumnMarshaler_MapPartialRow(t *testing.T) {
+	col := newTestColumn(t, "Map(String, Int64)")
+	require.NoError(t, col.AppendRow(map[string]testMoney{"a": 1}))
+	err := col.AppendRow(map[string]any{"b": testMoney(2), "c": testMoneyErr{}})
+	require.Error(t, err)
+	err = col.AppendRow(map[string]any{"b": testMoney(2), "c": "not a number"})
+	require.Error(t, err)
+
+	// the failed rows must not leave keys or values behind
+	m := col.(*Map)
+	assert.Equal(t, 1, col.Rows())
+	assert.Equal(t, 1, m.keys.Rows())
+	assert.Equal(t, 1, m.values.Rows())
+	require.NoError(t, col.AppendRow(map[string]testMoney{"d": 4}))
+	assert.Equal(t, map[string]int64{"d": 4}, col.Row(1, false))
+}
+
+// testMapValuer is a map type that is not the column scan type but converts itself through driver.Valuer.
+type testMapValuer map[string]string
+
+func (m testMapValuer) Value() (driver.Value, error) {
+	values := make(map[string]int64, len(m))
+	for k := range m {
+		values[k] = int64(len(m[k]))
+	}
+	return values, nil
+}
+
+func TestColumnMarshaler_MapValuer(t *testing.T) {
+	col := newTestColumn(t, "Map(String, Int64)")
+	require.NoError(t, col.AppendRow(testMapValuer{"a": "xyz"}))
+	assert.Equal(t, map[string]int64{"a": 3}, col.Row(0, false))
+}
@@ -0,0 +1,419 @@
+package column
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/ClickHouse/ch-go/proto"
+)
+
+// FlattenedNested is a view of the Array columns a Nested column is split into by the server when
+// flatten_nested = 1, e.g. n.a Array(String) and n.b Array(UInt8) for n Nested(a String, b UInt8).
+// Rows are appended from and scanned into slices of structs or maps, as for the Nested column itself.
+type FlattenedNested struct {
+	name    string
+	names   []string // sub-column names without the name of the Nested column
+	columns []*Array
+}
+
+// GroupFlattenedNested returns columns with each run of adjacent Array columns sharing a prefix, e.g. n.a and n.b,
+// replaced by a FlattenedNested column. A run is only grouped when its arrays have the same length in every row,
+// as the sub-columns of a Nested column do. Other columns are returned unchanged.
+func GroupFlattenedNested(columns []Interface) []Interface {
+	grouped := make([]Interface, 0, len(columns))
+	for _, c := range columns {
+		prefix, name, ok := strings.Cut(c.Name(), ".")
+		array, isArray := c.(*Array)
+		if !ok || !isArray || prefix == "" || name == "" {
+			grouped = append(grouped, c)
+			continue
+		}
+		if last, ok := lastFlattenedNested(grouped); ok && last.name == prefix {
+			last.names, last.columns = append(last.names, name), append(last.columns, array)
+			continue
+		}
+		grouped = append(grouped, &FlattenedNested{
+			name:    prefix,
+			names:   []string{name},
+			columns: []*Array{array},
+		})
+	}
+	return ungroupMismatched(grouped)
+}
+
+// ungroupMismatched replaces every FlattenedNested column whose arrays differ in length by its Array columns.
+func ungroupMismatched(columns []Interface) []Interface {
+	for i := 0; i < len(columns); i++ {
+		col, ok := columns[i].(*FlattenedNested)
+		if !ok || col.lengthsMatch() {
+			continue
+		}
+		columns = slices.Replace(columns, i, i+1, col.Columns()...)
+		i += len(col.columns) - 1
+	}
+	return columns
+}
+
+// lengthsMatch reports whether the arrays of all sub-columns have the same length in every row.
+func (col *FlattenedNested) lengthsMatch() bool {
+	offsets := col.columns[0].offsets[0].values.col
+	for _, c := range col.columns[1:] {
+		if !slices.Equal(offsets, c.offsets[0].values.col) {
+			return false
+		}
+	}
+	return true
+}
+
+func lastFlattenedNested(columns []Interface) (*FlattenedNested, bool) {
+	if len(columns) == 0 {
+		return nil, false
+	}
+	col, ok := columns[len(columns)-1].(*FlattenedNested)
+	return col, ok
+}
+
+func (col *FlattenedNested) Name() string {
+	return col.name
+}
+
+// Columns returns the Array columns of the view.
+func (col *FlattenedNested) Columns() []Interface {
+	columns := make([]Interface, len(col.columns))
+	for i, c := range col.columns {
+		columns[i] = c
+	}
+	return columns
+}
+
+func (col *FlattenedNested) Type() Type {
+	params := make([]string, len(col.columns))
+	for i, c := range col.columns {
+		params[i] = fmt.Sprintf("%s %s", col.names[i], c.Base().Type())
+	}
+	return Type(fmt.Sprintf("Nested(%s)", strings.Join(params, ", ")))
+}
+
+func (col *FlattenedNested) ScanType() reflect.Type {
+	return scanTypeSliceOfMaps
+}
+
+func (col *FlattenedNested) Rows() int {
+	return col.columns[0].Rows()
+}
+
+func (col *FlattenedNested) Reset() {
+	for _, c := range col.columns {
+		c.Reset()
+	}
+}
+
+func (col *FlattenedNested) Row(i int, ptr bool) any {
+	var value []map[string]any
+	if err := col.ScanRow(&value, i); err != nil {
+		return nil
+	}
+	if ptr {
+		return &value
+	}
+	return value
+}
+
+// ScanRow scans the row into a pointer to a slice of structs, pointers to structs or maps with string keys.
+// Struct fields are matched to sub-columns by their `ch` or `json` tag or their name, as for Tuple columns.
+func (col *FlattenedNested) ScanRow(dest any, row int) error {
+	target := reflect.ValueOf(dest)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return &ColumnConverterError{
+			Op:   "ScanRow",
+			To:   fmt.Sprintf("%T", dest),
+			From: string(col.Type()),
+			Hint: "dest must be a pointer",
+		}
+	}
+
+	elem := target.Elem()
+	sliceType := elem.Type()
+	if sliceType.Kind() == reflect.Interface {
+		sliceType = scanTypeSliceOfMaps
+	}
+	if col.passthrough(sliceType) {
+		return col.columns[0].ScanRow(dest, row)
+	}
+	if !isObjectSlice(sliceType) {
+		return &ColumnConverterError{
+			Op:   "ScanRow",
+			To:   fmt.Sprintf("%T", dest),
+			From: string(col.Type()),
+			Hint: "scan into a slice of structs or maps",
+		}
+	}
+
+	itemType := sliceType.Elem()
+	isPtr := itemType.Kind() == reflect.Pointer
+	if isPtr {
+		itemType = itemType.Elem()
+	}
+	if itemType.Kind() == reflect.Map && itemType.Key().Kind() != reflect.String {
+		return &Error{
+			ColumnType: string(col.Type()),
+			Err:        fmt.Errorf("column %s - map keys must be a string", col.Name()),
+		}
+	}
+
+	var (
+		length    = -1
+		subSlices = make([]reflect.Value, len(col.columns))
+		zero      = reflect.New(itemType).Elem()
+	)
+	for i, c := range col.columns {
+		var fieldType reflect.Type
+		switch itemType.Kind() {
+		case reflect.Struct:
+			field, ok := getStructFieldValue(zero, col.names[i])
+			if !ok {
+				continue
+			}
+			fieldType = field.Type()
+		default:
+			fieldType = itemType.Elem()
+		}
+
+		subSlice, err := c.scan(reflect.SliceOf(fieldType), row)
+		if err != nil {
+			return err
+		}
+		if length != -1 && subSlice.Len() != length {
+			return &Error{
+				ColumnType: string(col.Type()),
+				Err:        fmt.Errorf("column %s - sub-column %s has %d values, expected %d", col.Name(), col.names[i], subSlice.Len(), length),
+			}
+		}
+		length, subSlices[i] = subSlice.Len(), subSlice
+	}
+	if length == -1 {
+		length = 0
+	}
+
+	result := reflect.MakeSlice(sliceType, length, length)
+	for j := 0; j < length; j++ {
+		item := reflect.New(itemType).Elem()
+		if itemType.Kind() == reflect.Map {
+			item.Set(reflect.MakeMapWithSize(itemType, len(col.columns)))
+		}
+		for i, subSlice := range subSlices {
+			if !subSlice.IsValid() {
+				continue
+			}
+			switch itemType.Kind() {
+			case reflect.Struct:
+				field, _ := getStructFieldValue(item, col.names[i])
+				field.Set(subSlice.Index(j))
+			default:
+				item.SetMapIndex(reflect.ValueOf(col.names[i]).Convert(itemType.Key()), subSlice.Index(j))
+			}
+		}
+		if isPtr {
+			result.Index(j).Set(item.Addr())
+		} else {
+			result.Index(j).Set(item)
+		}
+	}
+	elem.Set(result)
+	return nil
+}
+
+// Append appends a slice of rows, each of them a slice of structs or maps.
+func (col *FlattenedNested) Append(v any) (nulls []uint8, err error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Slice {
+		return nil, &ColumnConverterError{
+			Op:   "Append",
+			To:   string(col.Type()),
+			From: fmt.Sprintf("%T", v),
+		}
+	}
+	for i := 0; i < value.Len(); i++ {
+		if err := col.AppendRow(value.Index(i).Interface()); err != nil {
+			return nil, err
+		}
+	}
+	return make([]uint8, value.Len()), nil
+}
+
+// AppendRow appends a slice of structs, pointers to structs or maps with string keys. Struct fields and map keys
+// must match the sub-columns, fields tagged with `ch:"-"` or `json:"-"` are ignored.
+func (col *FlattenedNested) AppendRow(v any) error {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !value.IsValid() && len(col.columns) == 1 || value.IsValid() && col.passthrough(value.Type()) {
+		return col.columns[0].AppendRow(v)
+	}
+
+	if !value.IsValid() || value.Kind() == reflect.Pointer {
+		// a nil slice appends an empty row
+		value = reflect.ValueOf([]map[string]any(nil))
+	}
+	if !isObjectSlice(value.Type()) {
+		return &ColumnConverterError{
+			Op:   "AppendRow",
+			To:   string(col.Type()),
+			From: fmt.Sprintf("%T", v),
+			Hint: "append a slice of structs or maps",
+		}
+	}
+
+	subSlices := make([][]any, len(col.columns))
+	for i := range subSlices {
+		subSlices[i] = make([]any, value.Len())
+	}
+	for j := 0; j < value.Len(); j++ {
+		item := value.Index(j)
+		for item.Kind() == reflect.Pointer || item.Kind() == reflect.Interface {
+			if item.IsNil() {
+				return &Error{
+					ColumnType: string(col.Type()),
+					Err:        fmt.Errorf("column %s - nil element %d", col.Name(), j),
+				}
+			}
+			item = item.Elem()
+		}
+		values, err := col.itemValues(item)
+		if err != nil {
+			return err
+		}
+		for i := range values {
+			subSlices[i][j] = values[i]
+		}
+	}
+
+	for i, c := range col.columns {
+		if err := c.AppendRow(subSlices[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// passthrough reports whether values of typ are for the only Array column of the view rather than slices of objects,
+// e.g. []string for n.a Array(String) or []map[string]string for n.a Array(Map(String, String)).
+func (col *FlattenedNested) passthrough(typ reflect.Type) bool {
+	if len(col.columns) != 1 {
+		return false
+	}
+	switch col.columns[0].Base().(type) {
+	case *Map, *Tuple, *Nested, *JSON:
+		return true
+	}
+	return !isObjectSlice(typ)
+}
+
+// itemValues returns the value of every sub-column from a struct or map.
+func (col *FlattenedNested) itemValues(item reflect.Value) ([]any, error) {
+	values := make([]any, len(col.columns))
+	found := make([]bool, len(col.columns))
+	index := make(map[string]int, len(col.names))
+	for i, name := range col.names {
+		index[name] = i
+	}
+
+	set := func(name string, value reflect.Value) error {
+		i, ok := index[name]
+		if !ok {
+			return &Error{
+				ColumnType: string(col.Type()),
+				Err:        fmt.Errorf("sub column '%s' does not exist in %s", name, col.Name()),
+			}
+		}
+		values[i], found[i] = value.Interface(), true
+		return nil
+	}
+
+	switch item.Kind() {
+	case reflect.Struct:
+		itemType := item.Type()
+		for i := 0; i < item.NumField(); i++ {
+			if !item.Field(i).CanInterface() {
+				continue
+			}
+			name, omit := getStructFieldName(itemType.Field(i))
+			if omit {
+				continue
+			}
+			if err := set(name, item.Field(i)); err != nil {
+				return nil, err
+			}
+		}
+	case reflect.Map:
+		if item.Type().Key().Kind() != reflect.String {
+			return nil, &Error{
+				ColumnType: fmt.Sprint(item.Type().Key().Kind()),
+				Err:        fmt.Errorf("map keys must be string for column %s", col.Name()),
+			}
+		}
+		iter := item.MapRange()
+		for iter.Next() {
+			if err := set(iter.Key().String(), iter.Value()); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, &ColumnConverterError{
+			Op:   "AppendRow",
+			To:   string(col.Type()),
+			From: item.Type().String(),
+		}
+	}
+
+	for i := range found {
+		if !found[i] {
+			return nil, &Error{
+				ColumnType: string(col.Type()),
+				Err:        fmt.Errorf("missing sub column '%s' of %s in %s", col.names[i], col.Name(), item.Type()),
+			}
+		}
+	}
+	return values, nil
+}
+
+func (col *FlattenedNested) Decode(reader *proto.Reader, rows int) error {
+	for _, c := range col.columns {
+		if err := c.Decode(reader, rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (col *FlattenedNested) Encode(buffer *proto.Buffer) {
+	for _, c := range col.columns {
+		c.Encode(buffer)
+	}
+}
+
+// isObjectSlice reports whether typ is a slice of structs, pointers to structs, maps or any.
+func isObjectSlice(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Slice && typ.Kind() != reflect.Array {
+		return false
+	}
+	elem := typ.Elem()
+	if elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+	switch elem.Kind() {
+	case reflect.Struct:
+		_, skip := iterateStructSkipTypes[elem]
+		return !skip
+	case reflect.Map:
+		return true
+	}
+	return false
+}
+
+var scanTypeSliceOfMaps = reflect.TypeOf([]map[string]any{})
+
+var _ Interface = (*FlattenedNested)(nil)
@@ -0,0 +1,141 @@
+package column
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nestedTestItem struct {
+	Name  string `ch:"name"`
+	Value uint8  `ch:"value"`
+}
+
+func flattenedNestedTestColumn(t *testing.T) *FlattenedNested {
+	name, err := Type("Array(String)").Column("n.name", nil)
+	require.NoError(t, err)
+	value, err := Type("Array(UInt8)").Column("n.value", nil)
+	require.NoError(t, err)
+	id, err := Type("UInt32").Column("id", nil)
+	require.NoError(t, err)
+
+	grouped := GroupFlattenedNested([]Interface{id, name, value})
+	require.Len(t, grouped, 2)
+	assert.Equal(t, id, grouped[0])
+	col, ok := grouped[1].(*FlattenedNested)
+	require.True(t, ok)
+	assert.Equal(t, "n", col.Name())
+	assert.Equal(t, Type("Nested(name String, value UInt8)"), col.Type())
+	return col
+}
+
+func TestFlattenedNested(t *testing.T) {
+	col := flattenedNestedTestColumn(t)
+
+	items := []nestedTestItem{{Name: "a", Value: 1}, {Name: "b", Value: 2}}
+	require.NoError(t, col.AppendRow(items))
+	require.NoError(t, col.AppendRow([]*nestedTestItem{{Name: "c", Value: 3}}))
+	require.NoError(t, col.AppendRow([]map[string]any{{"name": "d", "value": uint8(4)}}))
+	require.NoError(t, col.AppendRow(nil))
+	require.Equal(t, 4, col.Rows())
+	assert.Equal(t, []string{"a", "b"}, col.columns[0].Row(0, false))
+
+	var scanned []nestedTestItem
+	require.NoError(t, col.ScanRow(&scanned, 0))
+	assert.Equal(t, items, scanned)
+
+	var ptrs []*nestedTestItem
+	require.NoError(t, col.ScanRow(&ptrs, 1))
+	assert.Equal(t, []*nestedTestItem{{Name: "c", Value: 3}}, ptrs)
+
+	var maps []map[string]any
+	require.NoError(t, col.ScanRow(&maps, 2))
+	assert.Equal(t, []map[string]any{{"name": "d", "value": uint8(4)}}, maps)
+
+	require.NoError(t, col.ScanRow(&scanned, 3))
+	assert.Empty(t, scanned)
+
+	var dest any
+	require.NoError(t, col.ScanRow(&dest, 0))
+	assert.Equal(t, []map[string]any{{"name": "a", "value": uint8(1)}, {"name": "b", "value": uint8(2)}}, dest)
+}
+
+func TestFlattenedNestedErrors(t *testing.T) {
+	col := flattenedNestedTestColumn(t)
+
+	assert.Error(t, col.AppendRow([]map[string]any{{"name": "a"}}), "missing sub-column")
+	assert.Error(t, col.AppendRow([]map[string]any{{"name": "a", "value": uint8(1), "other": 1}}), "unknown sub-column")
+	assert.Error(t, col.AppendRow([]string{"a"}), "not a slice of objects")
+	assert.Equal(t, 0, col.Rows())
+
+	var values []string
+	assert.Error(t, col.ScanRow(&values, 0))
+}
+
+func TestFlattenedNestedSingleColumn(t *testing.T) {
+	name, err := Type("Array(String)").Column("n.name", nil)
+	require.NoError(t, err)
+	col := GroupFlattenedNested([]Interface{name})[0]
+
+	require.NoError(t, col.AppendRow([]string{"a", "b"}))
+	require.NoError(t, col.AppendRow([]nestedTestItem{{Name: "c"}}[:0]))
+
+	var values []string
+	require.NoError(t, col.ScanRow(&values, 0))
+	assert.Equal(t, []string{"a", "b"}, values)
+}
+
+func TestNested_ScanPointers(t *testing.T) {
+	col, err := Type("Nested(name String, value UInt8)").Column("n", nil)
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow([]nestedTestItem{{Name: "a", Value: 1}}))
+
+	var ptrs []*nestedTestItem
+	require.NoError(t, col.ScanRow(&ptrs, 0))
+	assert.Equal(t, []*nestedTestItem{{Name: "a", Value: 1}}, ptrs)
+}
+
+func TestFlattenedNestedOfNested(t *testing.T) {
+	type inner struct {
+		X uint8 `ch:"x"`
+		Y uint8 `ch:"y"`
+	}
+	type outer struct {
+		ID    uint8   `ch:"id"`
+		Inner []inner `ch:"inner"`
+	}
+
+	id, err := Type("Array(UInt8)").Column("n.id", nil)
+	require.NoError(t, err)
+	nested, err := Type("Array(Nested(x UInt8, y UInt8))").Column("n.inner", nil)
+	require.NoError(t, err)
+	col := GroupFlattenedNested([]Interface{id, nested})[0]
+
+	rows := []outer{{ID: 1, Inner: []inner{{X: 1, Y: 2}, {X: 3, Y: 4}}}, {ID: 2}}
+	require.NoError(t, col.AppendRow(rows))
+
+	var scanned []outer
+	require.NoError(t, col.ScanRow(&scanned, 0))
+	rows[1].Inner = []inner{}
+	assert.Equal(t, rows, scanned)
+}
+
+func TestGroupFlattenedNested_LengthMismatch(t *testing.T) {
+	name, err := Type("Array(String)").Column("n.name", nil)
+	require.NoError(t, err)
+	value, err := Type("Array(UInt8)").Column("n.value", nil)
+	require.NoError(t, err)
+	other, err := Type("Array(UInt8)").Column("m.value", nil)
+	require.NoError(t, err)
+	require.NoError(t, name.AppendRow([]string{"a", "b"}))
+	require.NoError(t, value.AppendRow([]uint8{1}))
+	require.NoError(t, other.AppendRow([]uint8{1}))
+
+	grouped := GroupFlattenedNested([]Interface{name, value, other})
+	require.Len(t, grouped, 3)
+	assert.Equal(t, name, grouped[0])
+	assert.Equal(t, value, grouped[1])
+	_, ok := grouped[2].(*FlattenedNested)
+	assert.True(t, ok)
+}
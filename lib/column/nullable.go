@@ -101,6 +101,9 @@ func (col *Nullable) ScanRow(dest any, row int) error {
 			case **time.Time:
 				*v = nil
 			}
+			if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+				return unmarshaler.UnmarshalColumn(nil)
+			}
 			if scan, ok := dest.(sql.Scanner); ok {
 				return scan.Scan(nil)
 			}
@@ -132,6 +135,13 @@ func (col *Nullable) AppendRow(v any) error {
 
 	if v == nil || ((rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Map) && rv.IsNil()) {
 		col.nulls.Append(1)
+		// user defined types may marshal to nil
+	} else if marshaler, ok := v.(ColumnMarshaler); ok {
+		val, err := marshalColumn(col, "AppendRow", marshaler)
+		if err != nil {
+			return err
+		}
+		return col.AppendRow(val)
 		// used to detect sql.Null* types
 	} else if val, ok := v.(driver.Valuer); ok {
 		val, err := val.Value()
@@ -103,6 +103,8 @@ func (col *QBit) ScanRow(dest any, row int) error {
 		for i, v := range vec {
 			(**d)[i] = float64(v)
 		}
+	case ColumnUnmarshaler:
+		return d.UnmarshalColumn(col.Row(row, false))
 	case sql.Scanner:
 		return d.Scan(vec)
 	default:
@@ -161,6 +163,9 @@ func (col *QBit) Append(v any) ([]uint8, error) {
 		}
 		return nulls, nil
 	default:
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -235,6 +240,13 @@ func (col *QBit) AppendRow(v any) error {
 		zeroVec := make([]float32, col.dimension)
 		return col.col.Append(zeroVec)
 	default:
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -0,0 +1,109 @@
+package column
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/ClickHouse/ch-go/proto"
+)
+
+// ColumnFactory creates a column for a ClickHouse type registered with Register.
+type ColumnFactory func(t Type, sc *ServerContext) (Interface, error)
+
+var registry = struct {
+	sync.RWMutex
+	factories map[string]ColumnFactory
+}{
+	factories: make(map[string]ColumnFactory),
+}
+
+// Register makes a user defined column implementation available for ClickHouse types starting with prefix,
+// e.g. "AggregateFunction(" or "Object('json')". Registered types take precedence over the types built into
+// the driver, which allows replacing a built-in codec. When several prefixes match a type the longest one wins.
+// Registering the same prefix again replaces the previous factory.
+func Register(prefix string, factory ColumnFactory) {
+	if len(prefix) == 0 {
+		panic("clickhouse: Register column type prefix is empty")
+	}
+	if factory == nil {
+		panic("clickhouse: Register column factory is nil")
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	registry.factories[prefix] = factory
+}
+
+// Unregister removes the column factory registered for prefix.
+func Unregister(prefix string) {
+	registry.Lock()
+	defer registry.Unlock()
+	delete(registry.factories, prefix)
+}
+
+func lookupFactory(t Type) (ColumnFactory, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	if len(registry.factories) == 0 {
+		return nil, false
+	}
+	var (
+		match   string
+		factory ColumnFactory
+	)
+	for prefix, f := range registry.factories {
+		if strings.HasPrefix(string(t), prefix) && len(prefix) > len(match) {
+			match, factory = prefix, f
+		}
+	}
+	return factory, factory != nil
+}
+
+func registeredColumn(factory ColumnFactory, t Type, name string, sc *ServerContext) (Interface, error) {
+	col, err := factory(t, sc)
+	if err != nil {
+		return nil, err
+	}
+	if col == nil {
+		return nil, &UnsupportedColumnTypeError{
+			t: t,
+		}
+	}
+	if col.Name() == name {
+		return col, nil
+	}
+	return &namedColumn{Interface: col, name: name}, nil
+}
+
+// namedColumn attaches the block column name to columns created by a ColumnFactory.
+type namedColumn struct {
+	Interface
+	name string
+}
+
+func (col *namedColumn) Name() string {
+	return col.name
+}
+
+// Unwrap returns the column created by the registered factory.
+func (col *namedColumn) Unwrap() Interface {
+	return col.Interface
+}
+
+func (col *namedColumn) ReadStatePrefix(reader *proto.Reader) error {
+	if serialize, ok := col.Interface.(CustomSerialization); ok {
+		return serialize.ReadStatePrefix(reader)
+	}
+	return nil
+}
+
+func (col *namedColumn) WriteStatePrefix(buffer *proto.Buffer) error {
+	if serialize, ok := col.Interface.(CustomSerialization); ok {
+		return serialize.WriteStatePrefix(buffer)
+	}
+	return nil
+}
+
+var (
+	_ Interface           = (*namedColumn)(nil)
+	_ CustomSerialization = (*namedColumn)(nil)
+)
@@ -0,0 +1,72 @@
+package column
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testStateColumn reads an unknown type as raw strings
+type testStateColumn struct {
+	String
+	chType Type
+}
+
+func (col *testStateColumn) Type() Type {
+	return col.chType
+}
+
+func TestRegister(t *testing.T) {
+	const prefix = "TestAggregateState("
+	Register(prefix, func(t Type, sc *ServerContext) (Interface, error) {
+		return &testStateColumn{chType: t}, nil
+	})
+	defer Unregister(prefix)
+
+	col, err := Type("TestAggregateState(uniq, String)").Column("state", &ServerContext{})
+	require.NoError(t, err)
+	assert.Equal(t, "state", col.Name())
+	assert.Equal(t, Type("TestAggregateState(uniq, String)"), col.Type())
+
+	require.NoError(t, col.AppendRow("raw"))
+	var v string
+	require.NoError(t, col.ScanRow(&v, 0))
+	assert.Equal(t, "raw", v)
+
+	// registered types are available inside composite types
+	arr, err := Type("Array(TestAggregateState(uniq, String))").Column("arr", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, arr.AppendRow([]string{"a", "b"}))
+	assert.Equal(t, 1, arr.Rows())
+}
+
+func TestRegister_LongestPrefix(t *testing.T) {
+	Register("TestPrefix", func(t Type, sc *ServerContext) (Interface, error) {
+		return &Int64{}, nil
+	})
+	defer Unregister("TestPrefix")
+	Register("TestPrefixLonger", func(t Type, sc *ServerContext) (Interface, error) {
+		return &UInt8{}, nil
+	})
+	defer Unregister("TestPrefixLonger")
+
+	col, err := Type("TestPrefixLonger(1)").Column("c", &ServerContext{})
+	require.NoError(t, err)
+	assert.Equal(t, Type("UInt8"), col.Type())
+
+	col, err = Type("TestPrefix(1)").Column("c", &ServerContext{})
+	require.NoError(t, err)
+	assert.Equal(t, Type("Int64"), col.Type())
+}
+
+func TestUnregister(t *testing.T) {
+	Register("TestUnknown", func(t Type, sc *ServerContext) (Interface, error) {
+		return &Int64{}, nil
+	})
+	Unregister("TestUnknown")
+
+	_, err := Type("TestUnknown").Column("c", &ServerContext{})
+	var unsupported *UnsupportedColumnTypeError
+	require.ErrorAs(t, err, &unsupported)
+}
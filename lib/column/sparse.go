@@ -0,0 +1,237 @@
+package column
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ClickHouse/ch-go/proto"
+)
+
+// SerializationKind is the kind of serialization the server uses for a column when the block
+// header flags it as having a custom serialization.
+type SerializationKind uint8
+
+const (
+	SerializationDefault SerializationKind = 0
+	SerializationSparse  SerializationKind = 1
+)
+
+// sparseEndOfGranule marks the trailing group of default values in sparse offsets.
+const sparseEndOfGranule = uint64(1) << 62
+
+// Decoder decodes the data of a column.
+type Decoder interface {
+	Decode(reader *proto.Reader, rows int) error
+}
+
+// ReadSerializationKinds reads the serialization kinds of col, including the kinds of Tuple elements, and returns
+// a Decoder that reads the column data in that serialization. Sparse data is materialized into col
+// so that it can be used like any other dense column.
+func ReadSerializationKinds(col Interface, reader *proto.Reader, sc *ServerContext) (Decoder, error) {
+	b, err := reader.UInt8()
+	if err != nil {
+		return nil, err
+	}
+	kind := SerializationKind(b)
+	switch kind {
+	case SerializationDefault, SerializationSparse:
+	default:
+		return nil, &Error{
+			ColumnType: string(col.Type()),
+			Err:        fmt.Errorf("unsupported serialization kind %d", kind),
+		}
+	}
+	var decoder Decoder = col
+	if tuple, ok := col.(*Tuple); ok {
+		elements := make([]Decoder, 0, len(tuple.columns))
+		for _, c := range tuple.columns {
+			element, err := ReadSerializationKinds(c, reader, sc)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, element)
+		}
+		decoder = tupleDecoder(elements)
+	}
+	if kind == SerializationSparse {
+		if !SupportsSparseSerialization(col) {
+			return nil, &Error{
+				ColumnType: string(col.Type()),
+				Err:        fmt.Errorf("sparse serialization is not supported"),
+			}
+		}
+		return &sparseDecoder{col: col, sc: sc}, nil
+	}
+	return decoder, nil
+}
+
+// DecodesSparse reports whether decoder, returned by ReadSerializationKinds, reads sparse data
+// for the column or for one of its Tuple elements.
+func DecodesSparse(decoder Decoder) bool {
+	switch decoder := decoder.(type) {
+	case *sparseDecoder:
+		return true
+	case tupleDecoder:
+		for _, element := range decoder {
+			if DecodesSparse(element) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type tupleDecoder []Decoder
+
+func (elements tupleDecoder) Decode(reader *proto.Reader, rows int) error {
+	for _, element := range elements {
+		if err := element.Decode(reader, rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SupportsSparseSerialization reports whether values of col can be sent with sparse serialization.
+// Like the server, only types without subtypes support it, so Nullable columns do not.
+func SupportsSparseSerialization(col Interface) bool {
+	switch col := col.(type) {
+	case *SimpleAggregateFunction:
+		return SupportsSparseSerialization(col.base)
+	case *Nullable, *Array, *Map, *Tuple, *Nested, *LowCardinality, *Variant, *Dynamic, *JSON,
+		*AggregateFunction, *Interval, *Nothing, *SharedVariant, *QBit,
+		*Point, *Ring, *Polygon, *MultiPolygon, *LineString, *MultiLineString, *Geometry:
+		return false
+	}
+	return true
+}
+
+type sparseDecoder struct {
+	col Interface
+	sc  *ServerContext
+}
+
+// Decode reads the offsets of non-default rows followed by the non-default values,
+// see src/DataTypes/Serializations/SerializationSparse.cpp in the ClickHouse repository.
+func (d *sparseDecoder) Decode(reader *proto.Reader, rows int) error {
+	offsets, err := readSparseOffsets(reader, rows)
+	if err != nil {
+		return &Error{
+			ColumnType: string(d.col.Type()),
+			Err:        fmt.Errorf("read sparse offsets: %w", err),
+		}
+	}
+	values, err := d.col.Type().Column(d.col.Name(), d.sc)
+	if err != nil {
+		return err
+	}
+	if err := values.Decode(reader, len(offsets)); err != nil {
+		return err
+	}
+	defaultValue, err := columnDefaultValue(d.col, d.sc)
+	if err != nil {
+		return err
+	}
+	next := 0
+	for i := 0; i < rows; i++ {
+		value := defaultValue
+		if next < len(offsets) && offsets[next] == i {
+			value = values.Row(next, false)
+			next++
+		}
+		if err := d.col.AppendRow(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readSparseOffsets(reader *proto.Reader, rows int) ([]int, error) {
+	var (
+		offsets []int
+		start   int
+	)
+	for {
+		group, err := reader.UVarInt()
+		if err != nil {
+			return nil, err
+		}
+		if group&sparseEndOfGranule != 0 {
+			if trailing := int(group &^ sparseEndOfGranule); start+trailing != rows {
+				return nil, fmt.Errorf("sparse offsets cover %d rows, expected %d", start+trailing, rows)
+			}
+			return offsets, nil
+		}
+		start += int(group)
+		if start >= rows {
+			return nil, fmt.Errorf("sparse offset %d out of range of %d rows", start, rows)
+		}
+		offsets = append(offsets, start)
+		start++
+	}
+}
+
+// zeroReader is an endless stream of zero bytes, which is the default value of every type supporting sparse serialization.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func columnDefaultValue(col Interface, sc *ServerContext) (any, error) {
+	defaults, err := col.Type().Column(col.Name(), sc)
+	if err != nil {
+		return nil, err
+	}
+	if err := defaults.Decode(proto.NewReader(zeroReader{}), 1); err != nil {
+		return nil, err
+	}
+	return defaults.Row(0, false), nil
+}
+
+// EncodeSparse writes col with sparse serialization when the share of default values is at least ratio.
+// It returns false without writing anything if the column should be sent with the default serialization.
+func EncodeSparse(col Interface, buffer *proto.Buffer, ratio float64, sc *ServerContext) (bool, error) {
+	rows := col.Rows()
+	if rows == 0 || ratio <= 0 || !SupportsSparseSerialization(col) {
+		return false, nil
+	}
+	defaultValue, err := columnDefaultValue(col, sc)
+	if err != nil {
+		return false, err
+	}
+	var offsets []int
+	for i := 0; i < rows; i++ {
+		if !reflect.DeepEqual(col.Row(i, false), defaultValue) {
+			offsets = append(offsets, i)
+		}
+	}
+	if float64(rows-len(offsets))/float64(rows) < ratio {
+		return false, nil
+	}
+	values, err := col.Type().Column(col.Name(), sc)
+	if err != nil {
+		return false, err
+	}
+	for _, offset := range offsets {
+		if err := values.AppendRow(col.Row(offset, false)); err != nil {
+			return false, err
+		}
+	}
+	buffer.PutBool(true)
+	buffer.PutUInt8(uint8(SerializationSparse))
+	if serialize, ok := values.(CustomSerialization); ok {
+		if err := serialize.WriteStatePrefix(buffer); err != nil {
+			return false, err
+		}
+	}
+	start := 0
+	for _, offset := range offsets {
+		buffer.PutUVarInt(uint64(offset - start))
+		start = offset + 1
+	}
+	buffer.PutUVarInt(uint64(rows-start) | sparseEndOfGranule)
+	values.Encode(buffer)
+	return true, nil
+}
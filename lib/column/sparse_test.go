@@ -0,0 +1,117 @@
+package column
+
+import (
+	"testing"
+
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sparseRoundTrip(t *testing.T, typ Type, values ...any) Interface {
+	sc := &ServerContext{}
+	col, err := typ.Column("sparse", sc)
+	require.NoError(t, err)
+	for _, v := range values {
+		require.NoError(t, col.AppendRow(v))
+	}
+
+	var buffer proto.Buffer
+	sparse, err := EncodeSparse(col, &buffer, 0.5, sc)
+	require.NoError(t, err)
+	require.True(t, sparse)
+
+	reader := buffer.Reader()
+	hasCustom, err := reader.Bool()
+	require.NoError(t, err)
+	require.True(t, hasCustom)
+
+	decoded, err := typ.Column("sparse", sc)
+	require.NoError(t, err)
+	decoder, err := ReadSerializationKinds(decoded, reader, sc)
+	require.NoError(t, err)
+	require.True(t, DecodesSparse(decoder))
+	require.NoError(t, decoder.Decode(reader, len(values)))
+	require.Equal(t, len(values), decoded.Rows())
+	for i := range values {
+		assert.Equal(t, col.Row(i, false), decoded.Row(i, false), "row %d", i)
+	}
+	return decoded
+}
+
+func TestSparse_RoundTrip(t *testing.T) {
+	sparseRoundTrip(t, "UInt64", uint64(0), uint64(0), uint64(5), uint64(0), uint64(0), uint64(7))
+	sparseRoundTrip(t, "String", "", "a", "", "", "")
+	sparseRoundTrip(t, "Decimal(9, 2)", decimal.Zero, decimal.Zero, decimal.New(125, -2), decimal.Zero)
+	sparseRoundTrip(t, "FixedString(3)", "", "", "abc", "")
+}
+
+func TestSparse_Offsets(t *testing.T) {
+	var buffer proto.Buffer
+	buffer.PutUVarInt(2)                      // rows 0,1 are default, row 2 is not
+	buffer.PutUVarInt(0)                      // row 3 is not default
+	buffer.PutUVarInt(1 | sparseEndOfGranule) // row 4 is default
+	offsets, err := readSparseOffsets(buffer.Reader(), 5)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, offsets)
+
+	buffer.Reset()
+	buffer.PutUVarInt(3 | sparseEndOfGranule)
+	_, err = readSparseOffsets(buffer.Reader(), 5)
+	require.Error(t, err)
+}
+
+func TestSparse_Tuple(t *testing.T) {
+	sc := &ServerContext{}
+	col, err := Type("Tuple(a UInt8, b String)").Column("t", sc)
+	require.NoError(t, err)
+
+	var buffer proto.Buffer
+	buffer.PutUInt8(uint8(SerializationDefault)) // tuple
+	buffer.PutUInt8(uint8(SerializationSparse))  // a
+	buffer.PutUInt8(uint8(SerializationDefault)) // b
+	// a: rows 0 and 2 are default
+	buffer.PutUVarInt(1)
+	buffer.PutUVarInt(1 | sparseEndOfGranule)
+	buffer.PutUInt8(9)
+	// b
+	for _, s := range []string{"x", "y", "z"} {
+		buffer.PutString(s)
+	}
+
+	reader := buffer.Reader()
+	decoder, err := ReadSerializationKinds(col, reader, sc)
+	require.NoError(t, err)
+	assert.True(t, DecodesSparse(decoder))
+	require.NoError(t, decoder.Decode(reader, 3))
+
+	var rows []map[string]any
+	for i := 0; i < 3; i++ {
+		rows = append(rows, col.Row(i, false).(map[string]any))
+	}
+	assert.Equal(t, []map[string]any{
+		{"a": uint8(0), "b": "x"},
+		{"a": uint8(9), "b": "y"},
+		{"a": uint8(0), "b": "z"},
+	}, rows)
+}
+
+func TestSparse_Unsupported(t *testing.T) {
+	sc := &ServerContext{}
+	for typ, value := range map[Type]any{"Array(UInt8)": []uint8{}, "Nullable(Int32)": nil} {
+		col, err := typ.Column("a", sc)
+		require.NoError(t, err)
+		require.NoError(t, col.AppendRow(value))
+
+		var buffer proto.Buffer
+		sparse, err := EncodeSparse(col, &buffer, 0.1, sc)
+		require.NoError(t, err)
+		assert.False(t, sparse, typ)
+		assert.Empty(t, buffer.Buf, typ)
+
+		buffer.PutUInt8(uint8(SerializationSparse))
+		_, err = ReadSerializationKinds(col, buffer.Reader(), sc)
+		require.Error(t, err, typ)
+	}
+}
@@ -14,8 +14,9 @@ import (
 )
 
 type String struct {
-	name string
-	col  proto.ColStr
+	name     string
+	col      proto.ColStr
+	zeroCopy bool
 }
 
 func (col *String) Reset() {
@@ -46,7 +47,30 @@ func (col *String) Row(i int, ptr bool) any {
 	return val
 }
 
+// UnsafeBytes returns the value at row i as a view of the column buffer.
+// The slice is only valid until the column is reset or the next block is read and must not be modified.
+func (col *String) UnsafeBytes(i int) []byte {
+	p := col.col.Pos[i]
+	return col.col.Buf[p.Start:p.End:p.End]
+}
+
+// UnsafeString returns the value at row i as a string sharing memory with the column buffer.
+// The string is only valid until the column is reset or the next block is read.
+func (col *String) UnsafeString(i int) string {
+	return binary.Bytes2Str(col.UnsafeBytes(i))
+}
+
 func (col *String) ScanRow(dest any, row int) error {
+	if col.zeroCopy {
+		switch d := dest.(type) {
+		case *[]byte:
+			*d = col.UnsafeBytes(row)
+			return nil
+		case *string:
+			*d = col.UnsafeString(row)
+			return nil
+		}
+	}
 	val := col.Row(row, false).(string)
 	switch d := dest.(type) {
 	case *string:
@@ -66,6 +90,8 @@ func (col *String) ScanRow(dest any, row int) error {
 	case **json.RawMessage:
 		*d = new(json.RawMessage)
 		**d = binary.Str2Bytes(val, len(val))
+	case ColumnUnmarshaler:
+		return d.UnmarshalColumn(val)
 	case encoding.BinaryUnmarshaler:
 		return d.UnmarshalBinary(binary.Str2Bytes(val, len(val)))
 	default:
@@ -117,6 +143,14 @@ func (col *String) AppendRow(v any) error {
 	case nil:
 		col.col.Append("")
 	default:
+		if marshaler, ok := v.(ColumnMarshaler); ok {
+			val, err := marshalColumn(col, "AppendRow", marshaler)
+			if err != nil {
+				return err
+			}
+			return col.AppendRow(val)
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -199,6 +233,10 @@ func (col *String) Append(v any) (nulls []uint8, err error) {
 		}
 	default:
 
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
+		}
+
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -49,47 +49,40 @@ func (col *Time) Row(i int, ptr bool) any {
 // It is used to read a single column value of the row and store in
 // `dest` Go variable.
 func (col *Time) ScanRow(dest any, row int) error {
-	switch d := dest.(type) {
-	case *time.Duration:
-		*d = col.row(row)
-	case **time.Duration:
-		*d = new(time.Duration)
-		**d = col.row(row)
-	default:
-		if scan, ok := dest.(sql.Scanner); ok {
-			return scan.Scan(col.row(row))
-		}
-		return &ColumnConverterError{
-			Op:   "ScanRow",
-			To:   fmt.Sprintf("%T", dest),
-			From: "Time",
+	value := col.row(row)
+	if ok, err := scanTimeValue(dest, value, 0); ok {
+		if err != nil {
+			return &ColumnConverterError{
+				Op:   "ScanRow",
+				To:   fmt.Sprintf("%T", dest),
+				From: "Time",
+				Hint: err.Error(),
+			}
 		}
+		return nil
+	}
+	if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+		return unmarshaler.UnmarshalColumn(value)
+	}
+	if scan, ok := dest.(sql.Scanner); ok {
+		return scan.Scan(value)
+	}
+	return &ColumnConverterError{
+		Op:   "ScanRow",
+		To:   fmt.Sprintf("%T", dest),
+		From: "Time",
 	}
-	return nil
 }
 
 // Append implements column.Interface.
 // It is used for columnar inserts. Insert multiple Go value for
 // single ClickHouse Time type.
 func (col *Time) Append(v any) (nulls []uint8, err error) {
-	switch v := v.(type) {
-	case []time.Duration:
-		nulls = make([]uint8, len(v)) // default all zeros, meaning no null values
-		for i := range v {
-			col.col.Append(proto.IntoTime32(v[i]))
+	durations, nulls, ok, err := timeDurations(v)
+	if !ok {
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
 		}
-	case []*time.Duration:
-		nulls = make([]uint8, len(v))
-		for i := range v {
-			switch {
-			case v[i] != nil:
-				col.col.Append(proto.IntoTime32(*v[i]))
-			default:
-				col.col.Append(proto.IntoTime32(time.Duration(0)))
-				nulls[i] = 1
-			}
-		}
-	default:
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -108,43 +101,75 @@ func (col *Time) Append(v any) (nulls []uint8, err error) {
 			From: fmt.Sprintf("%T", v),
 		}
 	}
-	return
+	if err != nil {
+		return nil, &ColumnConverterError{
+			Op:   "Append",
+			To:   "Time",
+			From: fmt.Sprintf("%T", v),
+			Hint: err.Error(),
+		}
+	}
+	// check the whole slice first so that no rows are appended on error
+	for _, d := range durations {
+		if err := checkTimeRange(col.chType, d); err != nil {
+			return nil, err
+		}
+	}
+	for _, d := range durations {
+		col.col.Append(col.into(d))
+	}
+	return nulls, nil
 }
 
 // AppendRow implements column.Interface.
 // It is used to insert column value in a row.
 // Converts Go type into ClickHouse type to be inserted.
 func (col *Time) AppendRow(v any) error {
-	switch v := v.(type) {
-	case time.Duration:
-		col.col.Append(proto.IntoTime32(v))
-	case *time.Duration:
-		switch {
-		case v != nil:
-			col.col.Append(proto.IntoTime32(*v))
-		default:
-			col.col.Append(proto.IntoTime32(time.Duration(0)))
-		}
-	default:
-		if valuer, ok := v.(driver.Valuer); ok {
-			val, err := valuer.Value()
-			if err != nil {
-				return &ColumnConverterError{
-					Op:   "AppendRow",
-					To:   "Time",
-					From: fmt.Sprintf("%T", v),
-					Hint: "could not get driver.Valuer value",
-				}
+	d, ok, err := timeDuration(v)
+	if ok {
+		if err != nil {
+			return &ColumnConverterError{
+				Op:   "AppendRow",
+				To:   "Time",
+				From: fmt.Sprintf("%T", v),
+				Hint: err.Error(),
 			}
-			return col.AppendRow(val)
 		}
-		return &ColumnConverterError{
-			Op:   "AppendRow",
-			To:   "Time",
-			From: fmt.Sprintf("%T", v),
+		if err := checkTimeRange(col.chType, d); err != nil {
+			return err
 		}
+		col.col.Append(col.into(d))
+		return nil
 	}
-	return nil
+	if marshaler, ok := v.(ColumnMarshaler); ok {
+		val, err := marshalColumn(col, "AppendRow", marshaler)
+		if err != nil {
+			return err
+		}
+		return col.AppendRow(val)
+	}
+	if valuer, ok := v.(driver.Valuer); ok {
+		val, err := valuer.Value()
+		if err != nil {
+			return &ColumnConverterError{
+				Op:   "AppendRow",
+				To:   "Time",
+				From: fmt.Sprintf("%T", v),
+				Hint: "could not get driver.Valuer value",
+			}
+		}
+		return col.AppendRow(val)
+	}
+	return &ColumnConverterError{
+		Op:   "AppendRow",
+		To:   "Time",
+		From: fmt.Sprintf("%T", v),
+	}
+}
+
+// into converts d into the column representation, truncated to whole seconds.
+func (col *Time) into(d time.Duration) proto.Time32 {
+	return proto.Time32(d / time.Second)
 }
 
 func (col *Time) Decode(reader *proto.Reader, rows int) error {
@@ -160,7 +185,7 @@ func (col *Time) row(i int) time.Duration {
 }
 
 func (col *Time) parseTime(value string) (time.Duration, error) {
-	return parseDuration(value)
+	return parseTimeValue(value)
 }
 
 // helpers
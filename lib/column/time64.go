@@ -73,47 +73,40 @@ func (col *Time64) Row(i int, ptr bool) any {
 // It is used to read a single column value of the row and store in
 // `dest` Go variable.
 func (col *Time64) ScanRow(dest any, row int) error {
-	switch d := dest.(type) {
-	case *time.Duration:
-		*d = col.row(row)
-	case **time.Duration:
-		*d = new(time.Duration)
-		**d = col.row(row)
-	default:
-		if scan, ok := dest.(sql.Scanner); ok {
-			return scan.Scan(col.row(row))
-		}
-		return &ColumnConverterError{
-			Op:   "ScanRow",
-			To:   fmt.Sprintf("%T", dest),
-			From: "Time64",
+	value := col.row(row)
+	if ok, err := scanTimeValue(dest, value, int(col.col.Precision)); ok {
+		if err != nil {
+			return &ColumnConverterError{
+				Op:   "ScanRow",
+				To:   fmt.Sprintf("%T", dest),
+				From: "Time64",
+				Hint: err.Error(),
+			}
 		}
+		return nil
+	}
+	if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+		return unmarshaler.UnmarshalColumn(value)
+	}
+	if scan, ok := dest.(sql.Scanner); ok {
+		return scan.Scan(value)
+	}
+	return &ColumnConverterError{
+		Op:   "ScanRow",
+		To:   fmt.Sprintf("%T", dest),
+		From: "Time64",
 	}
-	return nil
 }
 
 // Append implements column.Interface.
 // It is used for columnar inserts. Insert multiple Go value for
 // single ClickHouse Time64 type.
 func (col *Time64) Append(v any) (nulls []uint8, err error) {
-	switch v := v.(type) {
-	case []time.Duration:
-		nulls = make([]uint8, len(v)) // default all zeros, meaning no null values
-		for i := range v {
-			col.col.Append(proto.IntoTime64WithPrecision(v[i], col.col.Precision))
-		}
-	case []*time.Duration:
-		nulls = make([]uint8, len(v))
-		for i := range v {
-			switch {
-			case v[i] != nil:
-				col.col.Append(proto.IntoTime64WithPrecision(*v[i], col.col.Precision))
-			default:
-				col.col.Append(proto.IntoTime64WithPrecision(time.Duration(0), col.col.Precision))
-				nulls[i] = 1
-			}
+	durations, nulls, ok, err := timeDurations(v)
+	if !ok {
+		if nulls, ok, err := appendColumnMarshalers(col, v); ok {
+			return nulls, err
 		}
-	default:
 		if valuer, ok := v.(driver.Valuer); ok {
 			val, err := valuer.Value()
 			if err != nil {
@@ -132,43 +125,75 @@ func (col *Time64) Append(v any) (nulls []uint8, err error) {
 			From: fmt.Sprintf("%T", v),
 		}
 	}
-	return
+	if err != nil {
+		return nil, &ColumnConverterError{
+			Op:   "Append",
+			To:   "Time64",
+			From: fmt.Sprintf("%T", v),
+			Hint: err.Error(),
+		}
+	}
+	// check the whole slice first so that no rows are appended on error
+	for _, d := range durations {
+		if err := checkTimeRange(col.chType, d); err != nil {
+			return nil, err
+		}
+	}
+	for _, d := range durations {
+		col.col.Append(col.into(d))
+	}
+	return nulls, nil
 }
 
 // AppendRow implements column.Interface.
 // It is used to insert column value in a row.
 // Converts Go type into ClickHouse type to be inserted.
 func (col *Time64) AppendRow(v any) error {
-	switch v := v.(type) {
-	case time.Duration:
-		col.col.Append(proto.IntoTime64WithPrecision(v, col.col.Precision))
-	case *time.Duration:
-		switch {
-		case v != nil:
-			col.col.Append(proto.IntoTime64WithPrecision(*v, col.col.Precision))
-		default:
-			col.col.Append(proto.IntoTime64WithPrecision(time.Duration(0), col.col.Precision))
-		}
-	default:
-		if valuer, ok := v.(driver.Valuer); ok {
-			val, err := valuer.Value()
-			if err != nil {
-				return &ColumnConverterError{
-					Op:   "AppendRow",
-					To:   "Time64",
-					From: fmt.Sprintf("%T", v),
-					Hint: "could not get driver.Valuer value",
-				}
+	d, ok, err := timeDuration(v)
+	if ok {
+		if err != nil {
+			return &ColumnConverterError{
+				Op:   "AppendRow",
+				To:   "Time64",
+				From: fmt.Sprintf("%T", v),
+				Hint: err.Error(),
 			}
-			return col.AppendRow(val)
 		}
-		return &ColumnConverterError{
-			Op:   "AppendRow",
-			To:   "Time64",
-			From: fmt.Sprintf("%T", v),
+		if err := checkTimeRange(col.chType, d); err != nil {
+			return err
 		}
+		col.col.Append(col.into(d))
+		return nil
 	}
-	return nil
+	if marshaler, ok := v.(ColumnMarshaler); ok {
+		val, err := marshalColumn(col, "AppendRow", marshaler)
+		if err != nil {
+			return err
+		}
+		return col.AppendRow(val)
+	}
+	if valuer, ok := v.(driver.Valuer); ok {
+		val, err := valuer.Value()
+		if err != nil {
+			return &ColumnConverterError{
+				Op:   "AppendRow",
+				To:   "Time64",
+				From: fmt.Sprintf("%T", v),
+				Hint: "could not get driver.Valuer value",
+			}
+		}
+		return col.AppendRow(val)
+	}
+	return &ColumnConverterError{
+		Op:   "AppendRow",
+		To:   "Time64",
+		From: fmt.Sprintf("%T", v),
+	}
+}
+
+// into converts d into the column representation, truncated to the precision of the column.
+func (col *Time64) into(d time.Duration) proto.Time64 {
+	return proto.IntoTime64WithPrecision(d, col.col.Precision)
 }
 
 func (col *Time64) Decode(reader *proto.Reader, rows int) error {
@@ -184,5 +209,5 @@ func (col *Time64) row(i int) time.Duration {
 }
 
 func (col *Time64) parseTime(value string) (time.Duration, error) {
-	return parseDuration(value)
+	return parseTimeValue(value)
 }
@@ -1,6 +1,14 @@
 package column
 
-import "time"
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
+)
 
 // getTimeWithDifferentLocation returns the same time but with different location, e.g.
 // "2024-08-15 13:22:34 -03:00" will become "2024-08-15 13:22:34 +04:00".
@@ -10,3 +18,193 @@ func getTimeWithDifferentLocation(t time.Time, loc *time.Location) time.Time {
 
 	return time.Date(year, month, day, hour, minute, sec, t.Nanosecond(), loc)
 }
+
+// timeMax is the largest absolute value of the Time and Time64 types, 999:59:59.999999999.
+const timeMax = 1000*time.Hour - time.Nanosecond
+
+// timeDuration converts a Go value appended to a Time or Time64 column into a time.Duration,
+// ok is false for unsupported types. Nil pointers are appended as zero.
+func timeDuration(v any) (d time.Duration, ok bool, err error) {
+	switch v := v.(type) {
+	case nil:
+		return 0, true, nil
+	case time.Duration:
+		return v, true, nil
+	case *time.Duration:
+		if v != nil {
+			d = *v
+		}
+		return d, true, nil
+	case chcol.TimeOfDay:
+		if !v.Valid() {
+			_, err = v.Value()
+			return 0, true, err
+		}
+		return v.Duration(), true, nil
+	case *chcol.TimeOfDay:
+		if v == nil {
+			return 0, true, nil
+		}
+		return timeDuration(*v)
+	case time.Time:
+		return chcol.TimeOfDayOf(v).Duration(), true, nil
+	case *time.Time:
+		if v == nil {
+			return 0, true, nil
+		}
+		return timeDuration(*v)
+	case string:
+		d, err = parseTimeValue(v)
+		return d, true, err
+	case *string:
+		if v == nil {
+			return 0, true, nil
+		}
+		return timeDuration(*v)
+	}
+	return 0, false, nil
+}
+
+// timeDurations converts the slice types supported by Time and Time64 columns, ok is false for other types.
+// Nil pointers are converted to zero and reported as nulls.
+func timeDurations(v any) (durations []time.Duration, nulls []uint8, ok bool, err error) {
+	switch v := v.(type) {
+	case []time.Duration:
+		return v, make([]uint8, len(v)), true, nil
+	case []*time.Duration:
+		durations, nulls, err = convertTimeValues(v)
+	case []chcol.TimeOfDay:
+		durations, nulls, err = convertTimeValues(v)
+	case []*chcol.TimeOfDay:
+		durations, nulls, err = convertTimeValues(v)
+	case []time.Time:
+		durations, nulls, err = convertTimeValues(v)
+	case []*time.Time:
+		durations, nulls, err = convertTimeValues(v)
+	case []string:
+		durations, nulls, err = convertTimeValues(v)
+	case []*string:
+		durations, nulls, err = convertTimeValues(v)
+	default:
+		return nil, nil, false, nil
+	}
+	return durations, nulls, true, err
+}
+
+func convertTimeValues[T any](v []T) ([]time.Duration, []uint8, error) {
+	durations := make([]time.Duration, len(v))
+	nulls := make([]uint8, len(v))
+	for i := range v {
+		d, _, err := timeDuration(v[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		if rv := reflect.ValueOf(v[i]); rv.Kind() == reflect.Pointer && rv.IsNil() {
+			nulls[i] = 1
+		}
+		durations[i] = d
+	}
+	return durations, nulls, nil
+}
+
+// checkTimeRange returns an error if d is outside the range of the Time and Time64 types.
+func checkTimeRange(chType Type, d time.Duration) error {
+	if d > timeMax || d < -timeMax {
+		return &Error{
+			ColumnType: string(chType),
+			Err:        fmt.Errorf("%s is out of range [-999:59:59, 999:59:59]", formatTimeValue(d, 9)),
+		}
+	}
+	return nil
+}
+
+// scanTimeValue scans d into the destinations supported by Time and Time64 columns, ok is false for other types.
+// precision is the number of fractional digits of string values.
+func scanTimeValue(dest any, d time.Duration, precision int) (ok bool, err error) {
+	switch dest := dest.(type) {
+	case *time.Duration:
+		*dest = d
+	case **time.Duration:
+		*dest = new(time.Duration)
+		**dest = d
+	case *chcol.TimeOfDay:
+		*dest, err = chcol.TimeOfDayFromDuration(d)
+	case **chcol.TimeOfDay:
+		var t chcol.TimeOfDay
+		if t, err = chcol.TimeOfDayFromDuration(d); err == nil {
+			*dest = &t
+		}
+	case *string:
+		*dest = formatTimeValue(d, precision)
+	case **string:
+		*dest = new(string)
+		**dest = formatTimeValue(d, precision)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+// formatTimeValue formats d the way ClickHouse prints Time and Time64 values, e.g. -100:05:00.250 with precision 3.
+func formatTimeValue(d time.Duration, precision int) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+	}
+	// negating math.MinInt64 overflows, use unsigned arithmetic
+	abs := uint64(d)
+	if d < 0 {
+		abs = -abs
+	}
+
+	second := uint64(time.Second)
+	s := fmt.Sprintf("%s%02d:%02d:%02d", sign, abs/uint64(time.Hour), abs/uint64(time.Minute)%60, abs/second%60)
+	if precision > 0 {
+		s += fmt.Sprintf(".%09d", abs%second)[:precision+1]
+	}
+	return s
+}
+
+// parseTimeValue parses a Time or Time64 value such as "-12:30:00" or "100:00:00.5".
+// Go duration strings such as "1h30m" are accepted as well.
+func parseTimeValue(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if !strings.Contains(value, ":") {
+		return parseDuration(value)
+	}
+
+	clock := value
+	negative := strings.HasPrefix(clock, "-")
+	clock = strings.TrimPrefix(clock, "-")
+	clock, fraction, hasFraction := strings.Cut(clock, ".")
+
+	parts := strings.Split(clock, ":")
+	if len(parts) != 3 || len(parts[1]) != 2 || len(parts[2]) != 2 || (hasFraction && (fraction == "" || len(fraction) > 9)) {
+		return 0, fmt.Errorf("invalid time %q", value)
+	}
+
+	hours, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil || hours > 999 {
+		return 0, fmt.Errorf("invalid time %q", value)
+	}
+	minutes, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil || minutes > 59 {
+		return 0, fmt.Errorf("invalid time %q", value)
+	}
+	seconds, err := strconv.ParseUint(parts[2], 10, 8)
+	if err != nil || seconds > 59 {
+		return 0, fmt.Errorf("invalid time %q", value)
+	}
+	var nanos uint64
+	if hasFraction {
+		if nanos, err = strconv.ParseUint(fraction+strings.Repeat("0", 9-len(fraction)), 10, 32); err != nil {
+			return 0, fmt.Errorf("invalid time %q", value)
+		}
+	}
+
+	d := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second + time.Duration(nanos)
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
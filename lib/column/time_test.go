@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ClickHouse/ch-go/proto"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -326,3 +327,94 @@ func TestTime64_AppendRow(t *testing.T) {
 		})
 	}
 }
+
+func TestTime_NegativeAndRange(t *testing.T) {
+	maxTime := 999*time.Hour + 59*time.Minute + 59*time.Second
+	col := Time{chType: "Time"}
+	_, err := col.Append([]time.Duration{-maxTime, -1500 * time.Millisecond, maxTime})
+	require.NoError(t, err)
+	assert.Equal(t, []proto.Time32{-3599999, -1, 3599999}, col.col.Data)
+
+	var d time.Duration
+	require.NoError(t, col.ScanRow(&d, 0))
+	assert.Equal(t, -maxTime, d)
+
+	var s string
+	require.NoError(t, col.ScanRow(&s, 0))
+	assert.Equal(t, "-999:59:59", s)
+
+	_, err = col.Append([]time.Duration{time.Second, 1000 * time.Hour})
+	assert.Error(t, err)
+	assert.Error(t, col.AppendRow(-1000*time.Hour))
+	assert.Equal(t, 3, col.Rows(), "no rows are appended when a value is out of range")
+}
+
+func TestTime64_NegativeAndRange(t *testing.T) {
+	col := Time64{chType: "Time64(3)"}
+	col.col.WithPrecision(proto.PrecisionMilli)
+
+	maxTime64 := 1000*time.Hour - time.Millisecond
+	require.NoError(t, col.AppendRow(-maxTime64))
+	require.NoError(t, col.AppendRow("-00:00:01.5"))
+	require.NoError(t, col.AppendRow(maxTime64+999*time.Microsecond))
+	require.Error(t, col.AppendRow(1000*time.Hour))
+
+	expected := []time.Duration{-maxTime64, -1500 * time.Millisecond, maxTime64}
+	var strs []string
+	for i, want := range expected {
+		var d time.Duration
+		require.NoError(t, col.ScanRow(&d, i))
+		assert.Equal(t, want, d)
+
+		var s string
+		require.NoError(t, col.ScanRow(&s, i))
+		strs = append(strs, s)
+	}
+	assert.Equal(t, []string{"-999:59:59.999", "-00:00:01.500", "999:59:59.999"}, strs)
+}
+
+func TestTime_TimeOfDay(t *testing.T) {
+	opening := chcol.TimeOfDay{Hour: 9, Minute: 30}
+	closing := chcol.TimeOfDay{Hour: 17, Minute: 45, Second: 30, Nanosecond: 123_456_789}
+
+	col := Time64{chType: "Time64(6)"}
+	col.col.WithPrecision(proto.PrecisionMicro)
+	nulls, err := col.Append([]*chcol.TimeOfDay{&opening, nil, &closing})
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{0, 1, 0}, nulls)
+	require.NoError(t, col.AppendRow(time.Date(2024, 1, 1, 8, 15, 0, 0, time.UTC)))
+	require.NoError(t, col.AppendRow(-time.Hour))
+
+	var tod chcol.TimeOfDay
+	require.NoError(t, col.ScanRow(&tod, 0))
+	assert.Equal(t, opening, tod)
+
+	var ptr *chcol.TimeOfDay
+	require.NoError(t, col.ScanRow(&ptr, 2))
+	assert.Equal(t, chcol.TimeOfDay{Hour: 17, Minute: 45, Second: 30, Nanosecond: 123_456_000}, *ptr)
+
+	require.NoError(t, col.ScanRow(&tod, 3))
+	assert.Equal(t, chcol.TimeOfDay{Hour: 8, Minute: 15}, tod)
+
+	assert.Error(t, col.ScanRow(&tod, 4), "negative values are not a time of day")
+}
+
+func TestParseTimeValue(t *testing.T) {
+	cases := map[string]time.Duration{
+		"12:34:56":         12*time.Hour + 34*time.Minute + 56*time.Second,
+		"-100:00:00.25":    -(100*time.Hour + 250*time.Millisecond),
+		"999:59:59.999999": 1000*time.Hour - time.Microsecond,
+		"1h30m":            90 * time.Minute,
+		"":                 0,
+	}
+	for value, expected := range cases {
+		d, err := parseTimeValue(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, d, value)
+	}
+
+	for _, value := range []string{"1000:00:00", "12:60:00", "12:00", "12:00:00.1234567890", "--1:00:00"} {
+		_, err := parseTimeValue(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -183,6 +183,10 @@ func setJSONFieldValue(field reflect.Value, value reflect.Value) error {
 		}
 	}
 
+	if ok, err := unmarshalColumnValue(field, value.Interface()); ok {
+		return err
+	}
+
 	if value.CanConvert(field.Type()) {
 		field.Set(value.Convert(field.Type()))
 		return nil
@@ -455,6 +459,9 @@ func (col *Tuple) scan(targetType reflect.Type, row int) (reflect.Value, error)
 }
 
 func (col *Tuple) ScanRow(dest any, row int) error {
+	if unmarshaler, ok := dest.(ColumnUnmarshaler); ok {
+		return unmarshaler.UnmarshalColumn(col.Row(row, false))
+	}
 	value := reflect.Indirect(reflect.ValueOf(dest))
 	tuple, err := col.scan(value.Type(), row)
 	if err != nil {
@@ -494,6 +501,13 @@ func (col *Tuple) Append(v any) (nulls []uint8, err error) {
 }
 
 func (col *Tuple) AppendRow(v any) error {
+	if marshaler, ok := v.(ColumnMarshaler); ok {
+		val, err := marshalColumn(col, "AppendRow", marshaler)
+		if err != nil {
+			return err
+		}
+		return col.AppendRow(val)
+	}
 	// allows support of tuples where map or slice is typed and NOT any. Will fail if tuple isn't consistent
 	value := reflect.ValueOf(v)
 	if value.Kind() == reflect.Pointer {
@@ -696,8 +710,5 @@ func getStructFieldName(field reflect.StructField) (string, bool) {
 
 // ensures numeric keys and ` are escaped properly
 func getMapFieldName(name string) string {
-	if !escapeColRegex.MatchString(name) {
-		return fmt.Sprintf("`%s`", colEscape.Replace(name))
-	}
-	return colEscape.Replace(name)
+	return QuoteIdentifier(name)
 }
@@ -90,8 +90,14 @@ func (c *Variant) appendDiscriminatorRow(d uint8) {
 	c.discriminators = append(c.discriminators, d)
 }
 
+func (c *Variant) appendValueRow(d uint8) {
+	c.appendDiscriminatorRow(d)
+	c.offsets = append(c.offsets, c.columns[d].Rows()-1)
+}
+
 func (c *Variant) appendNullRow() {
 	c.appendDiscriminatorRow(VariantNullDiscriminator)
+	c.offsets = append(c.offsets, 0)
 }
 
 func (c *Variant) Name() string {
@@ -229,7 +235,7 @@ func (c *Variant) AppendRow(v any) error {
 			return fmt.Errorf("failed to append row to variant column with requested type %s: %w", requestedType, err)
 		}
 
-		c.appendDiscriminatorRow(typeIndex)
+		c.appendValueRow(typeIndex)
 		return nil
 	}
 
@@ -237,7 +243,7 @@ func (c *Variant) AppendRow(v any) error {
 	var err error
 	for i, col := range c.columns {
 		if err = col.AppendRow(v); err == nil {
-			c.appendDiscriminatorRow(uint8(i))
+			c.appendValueRow(uint8(i))
 			return nil
 		}
 	}
@@ -273,6 +279,7 @@ func (c *Variant) ScanType() reflect.Type {
 
 func (c *Variant) Reset() {
 	c.discriminators = c.discriminators[:0]
+	c.offsets = c.offsets[:0]
 
 	for _, col := range c.columns {
 		col.Reset()
@@ -0,0 +1,96 @@
+package column
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
+)
+
+// ScanVariant scans the value of a Variant or Dynamic into dest with the same rules as ScanRow of the column
+// of the value's ClickHouse type, e.g. a DateTime64(3) value can be scanned into *time.Time, *string or *int64.
+// dest is left unchanged for NULL values. Values without a ClickHouse type can only be scanned into a compatible Go type.
+func ScanVariant(v chcol.Variant, dest any) error {
+	if v.Nil() {
+		return nil
+	}
+
+	if v.Type() == "" {
+		target := reflect.ValueOf(dest)
+		if target.Kind() != reflect.Pointer || target.IsNil() || !reflect.TypeOf(v.Any()).AssignableTo(target.Elem().Type()) {
+			return &ColumnConverterError{
+				Op:   "ScanVariant",
+				To:   fmt.Sprintf("%T", dest),
+				From: fmt.Sprintf("%T", v.Any()),
+				Hint: "value has no ClickHouse type",
+			}
+		}
+
+		target.Elem().Set(reflect.ValueOf(v.Any()))
+		return nil
+	}
+
+	// LowCardinality values are scanned like their base type, the dictionary only exists for encoded blocks
+	chType := v.Type()
+	if strings.HasPrefix(chType, "LowCardinality(") {
+		chType = strings.TrimSuffix(strings.TrimPrefix(chType, "LowCardinality("), ")")
+	}
+
+	col, err := Type(chType).Column("", &ServerContext{})
+	if err != nil {
+		return err
+	}
+
+	if err := col.AppendRow(v.Any()); err != nil {
+		return err
+	}
+
+	return col.ScanRow(dest, 0)
+}
+
+// VariantAs returns the value of a Variant or Dynamic converted to T, see ScanVariant.
+func VariantAs[T any](v chcol.Variant) (T, error) {
+	var value T
+	err := ScanVariant(v, &value)
+	return value, err
+}
+
+// VariantTypes returns the sorted types of the non-NULL values in the current block.
+func (c *Variant) VariantTypes() []Type {
+	seen := make([]bool, len(c.columns))
+	for _, d := range c.discriminators {
+		if d != VariantNullDiscriminator && int(d) < len(seen) {
+			seen[d] = true
+		}
+	}
+
+	return usedColumnTypes(c.columns, seen)
+}
+
+// VariantTypes returns the sorted types of the non-NULL values in the current block.
+// Values in the shared variant are reported as SharedVariant.
+func (c *Dynamic) VariantTypes() []Type {
+	seen := make([]bool, len(c.columns))
+	for _, d := range c.discriminators {
+		// NULL is -1 while appending and the index after the last type when read from the server
+		if d != DynamicNullDiscriminator && d < len(seen) {
+			seen[d] = true
+		}
+	}
+
+	return usedColumnTypes(c.columns, seen)
+}
+
+func usedColumnTypes(columns []Interface, seen []bool) []Type {
+	var types []Type
+	for i, used := range seen {
+		if used {
+			types = append(types, columns[i].Type())
+		}
+	}
+	slices.Sort(types)
+
+	return types
+}
@@ -1,9 +1,14 @@
 package column
 
 import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"testing"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
 )
 
 func TestColVariant_parse(t *testing.T) {
@@ -64,6 +69,28 @@ func TestColVariant_addColumn(t *testing.T) {
 	require.Equal(t, uint8(0), col.columnTypeIndex["Int64"])
 }
 
+func TestColVariant_appendedRows(t *testing.T) {
+	col, err := Type("Variant(Int64, String)").Column("vt", &ServerContext{})
+	require.NoError(t, err)
+	for _, v := range []any{int64(1), "a", nil, int64(2), "b"} {
+		require.NoError(t, col.AppendRow(v))
+	}
+
+	// appended rows are read back from the position of the value in its type column
+	expected := []any{int64(1), "a", nil, int64(2), "b"}
+	for i, v := range expected {
+		var dest chcol.Variant
+		require.NoError(t, col.ScanRow(&dest, i))
+		assert.Equal(t, v, dest.Any(), "row %d", i)
+	}
+
+	col.Reset()
+	require.NoError(t, col.AppendRow("c"))
+	var dest chcol.Variant
+	require.NoError(t, col.ScanRow(&dest, 0))
+	assert.Equal(t, "c", dest.Any())
+}
+
 func TestColVariant_appendDiscriminatorRow(t *testing.T) {
 	col := Variant{}
 	var discriminator uint8 = 8
@@ -82,3 +109,49 @@ func TestColVariant_appendNullRow(t *testing.T) {
 	require.Equal(t, 1, len(col.discriminators))
 	require.Equal(t, VariantNullDiscriminator, col.discriminators[0])
 }
+
+func TestScanVariant(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 6_000_000, time.UTC)
+
+	var tm time.Time
+	require.NoError(t, ScanVariant(chcol.NewVariantWithType(ts, "DateTime64(3)"), &tm))
+	require.True(t, ts.Equal(tm))
+
+	id := uuid.New()
+	var str string
+	require.NoError(t, ScanVariant(chcol.NewVariantWithType(id, "UUID"), &str))
+	require.Equal(t, id.String(), str)
+
+	value, err := VariantAs[*string](chcol.NewDynamicWithType("text", "LowCardinality(String)"))
+	require.NoError(t, err)
+	require.Equal(t, "text", *value)
+
+	n, err := VariantAs[int64](chcol.NewVariant(int64(7)))
+	require.NoError(t, err)
+	require.Equal(t, int64(7), n)
+
+	_, err = VariantAs[string](chcol.NewVariant(int64(7)))
+	require.Error(t, err)
+	_, err = VariantAs[[]string](chcol.NewVariantWithType(int64(7), "Int64"))
+	require.Error(t, err)
+
+	str = "unchanged"
+	require.NoError(t, ScanVariant(chcol.NewVariant(nil), &str))
+	require.Equal(t, "unchanged", str)
+}
+
+func TestColVariant_VariantTypes(t *testing.T) {
+	col, err := Type("Variant(Int64, String, Array(UInt8))").Column("v", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow(chcol.NewVariantWithType("a", "String")))
+	require.NoError(t, col.AppendRow(nil))
+	require.NoError(t, col.AppendRow(chcol.NewVariantWithType(int64(1), "Int64")))
+	require.Equal(t, []Type{"Int64", "String"}, col.(*Variant).VariantTypes())
+
+	dyn, err := Type("Dynamic").Column("d", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, dyn.AppendRow(chcol.NewDynamicWithType(int64(1), "Int64")))
+	require.NoError(t, dyn.AppendRow(nil))
+	require.NoError(t, dyn.AppendRow(chcol.NewDynamicWithType("a", "String")))
+	require.Equal(t, []Type{"Int64", "String"}, dyn.(*Dynamic).VariantTypes())
+}
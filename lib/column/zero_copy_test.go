@@ -0,0 +1,97 @@
+package column
+
+import (
+	"testing"
+
+	"github.com/ClickHouse/ch-go/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringZeroCopy(t *testing.T) {
+	col, err := Type("String").Column("s", &ServerContext{ZeroCopy: true})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow("hello"))
+	require.NoError(t, col.AppendRow("world"))
+
+	var b []byte
+	require.NoError(t, col.ScanRow(&b, 1))
+	assert.Equal(t, []byte("world"), b)
+	assert.Equal(t, len(b), cap(b))
+
+	var s string
+	require.NoError(t, col.ScanRow(&s, 0))
+	assert.Equal(t, "hello", s)
+
+	str := col.(*String)
+	assert.Equal(t, "world", str.UnsafeString(1))
+	// views share memory with the block buffer
+	str.col.Buf[0] = 'j'
+	assert.Equal(t, "jello", s)
+}
+
+func TestStringCopy(t *testing.T) {
+	col, err := Type("String").Column("s", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow("hello"))
+
+	var b []byte
+	require.NoError(t, col.ScanRow(&b, 0))
+	col.(*String).col.Buf[0] = 'j'
+	assert.Equal(t, []byte("hello"), b)
+}
+
+func TestFixedStringCopy(t *testing.T) {
+	col, err := Type("FixedString(5)").Column("fs", &ServerContext{})
+	require.NoError(t, err)
+	require.NoError(t, col.AppendRow("hello"))
+
+	var b []byte
+	require.NoError(t, col.ScanRow(&b, 0))
+	// the scanned bytes do not alias the block buffer in either direction
+	col.(*FixedString).col.Buf[0] = 'j'
+	assert.Equal(t, []byte("hello"), b)
+	b[1] = 'a'
+	assert.Equal(t, "jello", col.Row(0, false))
+}
+
+func TestFixedStringZeroCopy(t *testing.T) {
+	for _, zeroCopy := range []bool{false, true} {
+		col, err := Type("FixedString(3)").Column("fs", &ServerContext{ZeroCopy: zeroCopy})
+		require.NoError(t, err)
+		require.NoError(t, col.AppendRow("abc"))
+		require.NoError(t, col.AppendRow("def"))
+
+		var (
+			b []byte
+			s string
+		)
+		require.NoError(t, col.ScanRow(&b, 1))
+		require.NoError(t, col.ScanRow(&s, 1))
+		assert.Equal(t, "def", col.(*FixedString).UnsafeString(1))
+
+		col.(*FixedString).col.Buf[3] = 'x'
+		if zeroCopy {
+			assert.Equal(t, []byte("xef"), b)
+			assert.Equal(t, "xef", s)
+		} else {
+			assert.Equal(t, []byte("def"), b)
+			assert.Equal(t, "def", s)
+		}
+	}
+}
+
+func TestStringZeroCopyDecode(t *testing.T) {
+	var buffer proto.Buffer
+	source := &String{}
+	require.NoError(t, source.AppendRow("first"))
+	require.NoError(t, source.AppendRow("second"))
+	source.Encode(&buffer)
+
+	col, err := Type("String").Column("s", &ServerContext{ZeroCopy: true})
+	require.NoError(t, err)
+	require.NoError(t, col.Decode(proto.NewReader(buffer.Reader()), 2))
+	var b []byte
+	require.NoError(t, col.ScanRow(&b, 1))
+	assert.Equal(t, []byte("second"), b)
+}
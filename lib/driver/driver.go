@@ -2,6 +2,7 @@ package driver
 
 import (
 	"context"
+	"io"
 	"reflect"
 	"time"
 
@@ -40,13 +41,34 @@ type (
 		QueryRow(ctx context.Context, query string, args ...any) Row
 		PrepareBatch(ctx context.Context, query string, opts ...PrepareBatchOption) (Batch, error)
 		Exec(ctx context.Context, query string, args ...any) error
-
 		// Deprecated: use context aware `WithAsync()` for any async operations
 		AsyncInsert(ctx context.Context, query string, wait bool, args ...any) error
 		Ping(context.Context) error
 		Stats() Stats
 		Close() error
 	}
+	// ScriptExecer is implemented by a Conn that can execute multi-statement scripts. It is kept out of Conn
+	// so that existing Conn implementations keep compiling, see clickhouse.ExecScript.
+	ScriptExecer interface {
+		// ExecScript executes the ;-separated statements of script one after another on a single connection.
+		ExecScript(ctx context.Context, script string, opts ...ScriptOption) ([]StatementResult, error)
+	}
+	// ResultExecer is implemented by a Conn that can report the outcome of a statement, see clickhouse.ExecWithResult.
+	ResultExecer interface {
+		// ExecWithResult executes the statement like Exec and returns the query ID and the rows and bytes it wrote.
+		ExecWithResult(ctx context.Context, query string, args ...any) (ExecResult, error)
+	}
+	// RawQueryer is implemented by a Conn that can return query results without decoding them, see clickhouse.QueryRaw.
+	RawQueryer interface {
+		// QueryRaw executes the query and returns the undecoded result in the given format, e.g. CSV or Parquet.
+		QueryRaw(ctx context.Context, query, format string) (io.ReadCloser, error)
+	}
+	// RawInserter is implemented by a Conn that can insert data which is already encoded in a ClickHouse format,
+	// see clickhouse.InsertRaw.
+	RawInserter interface {
+		// InsertRaw inserts data encoded in the given format into table, optionally restricted to columns.
+		InsertRaw(ctx context.Context, table, format string, data io.Reader, columns ...string) error
+	}
 	Row interface {
 		Err() error
 		Scan(dest ...any) error
@@ -84,4 +106,31 @@ type (
 		ScanType() reflect.Type
 		DatabaseTypeName() string
 	}
+	// ExecResult is the outcome of a statement executed by ResultExecer.ExecWithResult.
+	ExecResult struct {
+		QueryID string
+		Summary StatementSummary
+	}
+	// StatementResult is the outcome of a statement executed by ScriptExecer.ExecScript.
+	StatementResult struct {
+		ExecResult
+		Statement string // the statement without the separating semicolon and leading comments
+		Line      int    // the line of the script the statement starts at, from 1
+		Err       error  // the exception of the statement, nil if it succeeded
+	}
+	// StatementSummary is the progress reported by the server for a statement, from the Progress packets
+	// of the native protocol or the X-ClickHouse-Summary header of HTTP responses.
+	StatementSummary struct {
+		ReadRows     uint64
+		ReadBytes    uint64
+		WrittenRows  uint64
+		WrittenBytes uint64
+		Elapsed      time.Duration // reported by the server, or measured by the client if the server does not report it
+	}
+	// VariantColumnType is implemented by the column types of Variant and Dynamic columns.
+	VariantColumnType interface {
+		ColumnType
+		// VariantTypes returns the sorted types of the non-NULL values in the current block.
+		VariantTypes() []string
+	}
 )
@@ -3,6 +3,12 @@ package driver
 type PrepareBatchOptions struct {
 	ReleaseConnection bool
 	CloseOnFlush      bool
+	// SparseSerializationRatio is the minimum share of default values for a column to be sent with sparse serialization.
+	SparseSerializationRatio float64
+	// LowCardinalityDictionaries pre-seeds the dictionaries of LowCardinality columns, keyed by column name.
+	LowCardinalityDictionaries map[string]any
+	// ReuseLowCardinalityDictionaries keeps the dictionaries of LowCardinality columns across the blocks sent by Flush.
+	ReuseLowCardinalityDictionaries bool
 }
 
 type PrepareBatchOption func(options *PrepareBatchOptions)
@@ -19,3 +25,49 @@ func WithCloseOnFlush() PrepareBatchOption {
 		options.CloseOnFlush = true
 	}
 }
+
+// WithSparseSerialization sends columns in which the share of default values (zero or empty) is at least ratio
+// with sparse serialization, which transfers only the non-default values. Nullable and composite columns
+// are always sent in full. Native protocol only, the server must support revision 54465.
+func WithSparseSerialization(ratio float64) PrepareBatchOption {
+	return func(options *PrepareBatchOptions) {
+		options.SparseSerializationRatio = ratio
+	}
+}
+
+// WithLowCardinalityDictionary pre-seeds the dictionary of the LowCardinality column with a slice of known values,
+// e.g. log levels or tenant names. The dictionary is kept across the blocks sent by Flush.
+// Only top-level LowCardinality columns are supported, not those nested in Array, Map or Tuple columns.
+func WithLowCardinalityDictionary(column string, values any) PrepareBatchOption {
+	return func(options *PrepareBatchOptions) {
+		if options.LowCardinalityDictionaries == nil {
+			options.LowCardinalityDictionaries = make(map[string]any)
+		}
+		options.LowCardinalityDictionaries[column] = values
+	}
+}
+
+// WithLowCardinalityDictionaryReuse keeps the dictionaries of LowCardinality columns across the blocks sent by Flush
+// instead of rebuilding them for every block. Every block still carries the whole dictionary, so this suits columns
+// with few distinct values; a dictionary growing beyond 16384 values is rebuilt for the next block.
+// Only top-level LowCardinality columns are covered, not those nested in Array, Map or Tuple columns.
+func WithLowCardinalityDictionaryReuse() PrepareBatchOption {
+	return func(options *PrepareBatchOptions) {
+		options.ReuseLowCardinalityDictionaries = true
+	}
+}
+
+type ScriptOptions struct {
+	// ContinueOnError executes the remaining statements of a script after a statement failed.
+	ContinueOnError bool
+}
+
+type ScriptOption func(options *ScriptOptions)
+
+// WithContinueOnError executes every statement of a script even if some of them fail,
+// by default ExecScript stops at the first failed statement.
+func WithContinueOnError() ScriptOption {
+	return func(options *ScriptOptions) {
+		options.ContinueOnError = true
+	}
+}
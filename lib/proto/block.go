@@ -13,6 +13,12 @@ type Block struct {
 	Packet        byte
 	Columns       []column.Interface
 	ServerContext *column.ServerContext
+	// SparseRatio enables sparse serialization on Encode for columns where the share of default values
+	// is at least the given ratio. Zero disables sparse serialization.
+	SparseRatio float64
+
+	grouped []column.Interface // Columns with flattened Nested columns grouped, see GroupedColumns
+	sparse  []string           // names of the columns decoded from sparse serialization, see SparseColumns
 }
 
 func NewBlock() *Block {
@@ -34,11 +40,18 @@ func (b *Block) AddColumn(name string, ct column.Type) error {
 		return err
 	}
 	b.names, b.Columns = append(b.names, name), append(b.Columns, col)
+	b.grouped = nil
 	return nil
 }
 
 func (b *Block) Append(v ...any) (err error) {
 	columns := b.Columns
+	if len(columns) != len(v) {
+		if grouped := b.GroupedColumns(); len(grouped) == len(v) {
+			// a Nested column flattened into Array columns appended as a slice of structs or maps
+			columns = grouped
+		}
+	}
 	if len(columns) != len(v) {
 		return &BlockError{
 			Op:  "Append",
@@ -46,7 +59,7 @@ func (b *Block) Append(v ...any) (err error) {
 		}
 	}
 	for i, v := range v {
-		if err := b.Columns[i].AppendRow(v); err != nil {
+		if err := columns[i].AppendRow(v); err != nil {
 			return &BlockError{
 				Op:         "AppendRow",
 				Err:        err,
@@ -61,6 +74,15 @@ func (b *Block) ColumnsNames() []string {
 	return b.names
 }
 
+// GroupedColumns returns the columns of the block with the Array columns of every Nested column flattened by
+// the server (flatten_nested = 1) grouped into a single column.FlattenedNested column.
+func (b *Block) GroupedColumns() []column.Interface {
+	if b.grouped == nil {
+		b.grouped = column.GroupFlattenedNested(b.Columns)
+	}
+	return b.grouped
+}
+
 // SortColumns sorts our block according to the requested order - a slice of column names. Names must be identical in requested order and block.
 func (b *Block) SortColumns(columns []string) error {
 	if len(columns) == 0 {
@@ -87,6 +109,7 @@ func (b *Block) SortColumns(columns []string) error {
 		iRank, jRank := lookup[b.names[i]], lookup[b.names[j]]
 		return iRank < jRank
 	})
+	b.grouped = nil
 	return nil
 }
 
@@ -133,6 +156,19 @@ func (b *Block) EncodeColumn(buffer *proto.Buffer, revision uint64, i int) (err
 		buffer.PutString(string(c.Type()))
 
 		if revision >= DBMS_MIN_REVISION_WITH_CUSTOM_SERIALIZATION {
+			if b.SparseRatio > 0 && revision >= DBMS_MIN_REVISION_WITH_SPARSE_SERIALIZATION {
+				sparse, err := column.EncodeSparse(c, buffer, b.SparseRatio, b.ServerContext)
+				if err != nil {
+					return &BlockError{
+						Op:         "Encode",
+						Err:        err,
+						ColumnName: c.Name(),
+					}
+				}
+				if sparse {
+					return nil
+				}
+			}
 			buffer.PutBool(false)
 		}
 
@@ -190,6 +226,8 @@ func (b *Block) Decode(reader *proto.Reader, revision uint64) (err error) {
 	}
 	b.Columns = make([]column.Interface, numCols, numCols)
 	b.names = make([]string, numCols, numCols)
+	b.grouped = nil
+	b.sparse = nil
 	for i := 0; i < int(numCols); i++ {
 		var (
 			columnName string
@@ -206,15 +244,22 @@ func (b *Block) Decode(reader *proto.Reader, revision uint64) (err error) {
 			return err
 		}
 
+		var decoder column.Decoder = c
 		if revision >= DBMS_MIN_REVISION_WITH_CUSTOM_SERIALIZATION {
 			hasCustom, err := reader.Bool()
 			if err != nil {
 				return err
 			}
 			if hasCustom {
-				return &BlockError{
-					Op:  "Decode",
-					Err: fmt.Errorf("custom serialization for column %s. not supported by clickhouse-go driver", columnName),
+				if decoder, err = column.ReadSerializationKinds(c, reader, b.ServerContext); err != nil {
+					return &BlockError{
+						Op:         "Decode",
+						Err:        err,
+						ColumnName: columnName,
+					}
+				}
+				if column.DecodesSparse(decoder) {
+					b.sparse = append(b.sparse, columnName)
 				}
 			}
 		}
@@ -229,7 +274,7 @@ func (b *Block) Decode(reader *proto.Reader, revision uint64) (err error) {
 					}
 				}
 			}
-			if err := c.Decode(reader, int(numRows)); err != nil {
+			if err := decoder.Decode(reader, int(numRows)); err != nil {
 				return &BlockError{
 					Op:         "Decode",
 					Err:        err,
@@ -243,6 +288,12 @@ func (b *Block) Decode(reader *proto.Reader, revision uint64) (err error) {
 	return nil
 }
 
+// SparseColumns returns the names of the columns the server sent with sparse serialization
+// in the last decoded block. They are materialized into dense columns.
+func (b *Block) SparseColumns() []string {
+	return b.sparse
+}
+
 func (b *Block) Reset() {
 	for i := range b.Columns {
 		b.Columns[i].Reset()
@@ -20,7 +20,12 @@ const (
 	DBMS_MIN_PROTOCOL_VERSION_WITH_QUOTA_KEY                    = 54458
 	DBMS_MIN_PROTOCOL_VERSION_WITH_PARAMETERS                   = 54459
 	DBMS_MIN_PROTOCOL_VERSION_WITH_SERVER_QUERY_TIME_IN_PROGRES = 54460
-	DBMS_TCP_PROTOCOL_VERSION                                   = DBMS_MIN_PROTOCOL_VERSION_WITH_SERVER_QUERY_TIME_IN_PROGRES
+	DBMS_MIN_PROTOCOL_VERSION_WITH_PASSWORD_COMPLEXITY_RULES    = 54461
+	DBMS_MIN_REVISION_WITH_INTERSERVER_SECRET_V2                = 54462
+	DBMS_MIN_PROTOCOL_VERSION_WITH_TOTAL_BYTES_IN_PROGRESS      = 54463
+	DBMS_MIN_PROTOCOL_VERSION_WITH_TIMEZONE_UPDATES             = 54464
+	DBMS_MIN_REVISION_WITH_SPARSE_SERIALIZATION                 = 54465
+	DBMS_TCP_PROTOCOL_VERSION                                   = DBMS_MIN_REVISION_WITH_SPARSE_SERIALIZATION
 )
 
 const (
@@ -63,4 +68,5 @@ const (
 	ServerReadTaskRequest     = 13
 	ServerProfileEvents       = 14
 	ServerTreeReadTaskRequest = 15
+	ServerTimezoneUpdate      = 17
 )
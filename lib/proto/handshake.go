@@ -102,6 +102,25 @@ func (srv *ServerHandshake) Decode(reader *chproto.Reader) (err error) {
 	} else {
 		srv.Version.Patch = srv.Revision
 	}
+	// the server sends the following fields depending on the revision of the client
+	revision := min(srv.Revision, DBMS_TCP_PROTOCOL_VERSION)
+	if revision >= DBMS_MIN_PROTOCOL_VERSION_WITH_PASSWORD_COMPLEXITY_RULES {
+		rules, err := reader.UVarInt()
+		if err != nil {
+			return fmt.Errorf("could not read password complexity rules: %v", err)
+		}
+		for i := uint64(0); i < rules*2; i++ {
+			// pattern and exception message
+			if _, err := reader.Str(); err != nil {
+				return fmt.Errorf("could not read password complexity rule: %v", err)
+			}
+		}
+	}
+	if revision >= DBMS_MIN_REVISION_WITH_INTERSERVER_SECRET_V2 {
+		if _, err := reader.UInt64(); err != nil {
+			return fmt.Errorf("could not read interserver secret nonce: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,48 @@
+package proto
+
+import (
+	"testing"
+
+	chproto "github.com/ClickHouse/ch-go/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerHandshakeDecode(t *testing.T) {
+	var buffer chproto.Buffer
+	buffer.PutString("ClickHouse")
+	buffer.PutUVarInt(25)
+	buffer.PutUVarInt(8)
+	buffer.PutUVarInt(54479) // newer than the client, the client revision applies
+	buffer.PutString("UTC")
+	buffer.PutString("node-1")
+	buffer.PutUVarInt(3)
+	buffer.PutUVarInt(1) // password complexity rules
+	buffer.PutString(".{12}")
+	buffer.PutString("at least 12 characters")
+	buffer.PutUInt64(42) // nonce
+	buffer.PutString("next packet")
+
+	reader := chproto.NewReader(buffer.Reader())
+	var srv ServerHandshake
+	require.NoError(t, srv.Decode(reader))
+	assert.Equal(t, "node-1", srv.DisplayName)
+	assert.Equal(t, Version{Major: 25, Minor: 8, Patch: 3}, srv.Version)
+	next, err := reader.Str()
+	require.NoError(t, err)
+	assert.Equal(t, "next packet", next)
+}
+
+func TestProgressDecode(t *testing.T) {
+	var buffer chproto.Buffer
+	for _, v := range []uint64{10, 80, 100, 800, 1, 8, 1500} {
+		buffer.PutUVarInt(v)
+	}
+	var progress Progress
+	require.NoError(t, progress.Decode(chproto.NewReader(buffer.Reader()), DBMS_TCP_PROTOCOL_VERSION))
+	assert.Equal(t, uint64(100), progress.TotalRows)
+	assert.Equal(t, uint64(800), progress.TotalBytes)
+	assert.Equal(t, uint64(1), progress.WroteRows)
+	assert.Equal(t, uint64(8), progress.WroteBytes)
+	assert.EqualValues(t, 1500, progress.Elapsed)
+}
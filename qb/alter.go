@@ -0,0 +1,132 @@
+package qb
+
+import (
+	"errors"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+)
+
+// AlterBuilder builds an ALTER TABLE statement: an UPDATE or DELETE mutation, or changes of columns.
+type AlterBuilder struct {
+	table    string
+	cluster  string
+	updates  []assignment
+	delete   bool
+	where    []Expr
+	actions  []Expr
+	settings []setting
+}
+
+type assignment struct {
+	column string
+	value  any
+}
+
+// Alter starts an ALTER TABLE of the table, a database may be given as in db.table.
+func Alter(table string) *AlterBuilder {
+	return &AlterBuilder{table: table}
+}
+
+// OnCluster runs the statement on every host of the cluster.
+func (q *AlterBuilder) OnCluster(cluster string) *AlterBuilder {
+	q.cluster = cluster
+	return q
+}
+
+// Update sets the column to value in the rows matching Where. value is a query parameter unless it is an expression.
+func (q *AlterBuilder) Update(column string, value any) *AlterBuilder {
+	q.updates = append(q.updates, assignment{column: column, value: value})
+	return q
+}
+
+// Delete deletes the rows matching Where.
+func (q *AlterBuilder) Delete() *AlterBuilder {
+	q.delete = true
+	return q
+}
+
+// Where adds conditions selecting the rows of an UPDATE or DELETE, they are joined by AND.
+func (q *AlterBuilder) Where(conditions ...Expr) *AlterBuilder {
+	q.where = append(q.where, conditions...)
+	return q
+}
+
+// AddColumn adds a column of chType, e.g. AddColumn("score", "Float64").
+func (q *AlterBuilder) AddColumn(column, chType string) *AlterBuilder {
+	q.actions = append(q.actions, exprFunc(func(b *builder) {
+		b.write("ADD COLUMN ")
+		b.ident(column)
+		b.write(" ", chType)
+	}))
+	return q
+}
+
+// DropColumn drops a column.
+func (q *AlterBuilder) DropColumn(column string) *AlterBuilder {
+	q.actions = append(q.actions, exprFunc(func(b *builder) {
+		b.write("DROP COLUMN ")
+		b.ident(column)
+	}))
+	return q
+}
+
+// Settings adds a setting to the SETTINGS clause, e.g. Settings("mutations_sync", 2).
+func (q *AlterBuilder) Settings(name string, value any) *AlterBuilder {
+	q.settings = append(q.settings, setting{name: name, value: value})
+	return q
+}
+
+// Build returns the statement and the values of its query parameters.
+func (q *AlterBuilder) Build() (string, clickhouse.Parameters, error) {
+	mutation := len(q.updates) > 0 || q.delete
+	switch {
+	case len(q.updates) > 0 && q.delete:
+		return "", nil, errors.New("qb: ALTER TABLE with both UPDATE and DELETE")
+	case mutation && len(q.actions) > 0:
+		return "", nil, errors.New("qb: ALTER TABLE mixes a mutation with column changes")
+	case mutation && len(q.where) == 0:
+		return "", nil, errors.New("qb: ALTER TABLE UPDATE and DELETE require a WHERE condition")
+	case !mutation && len(q.where) > 0:
+		return "", nil, errors.New("qb: WHERE without UPDATE or DELETE")
+	case !mutation && len(q.actions) == 0:
+		return "", nil, errors.New("qb: ALTER TABLE without changes")
+	}
+
+	b := newBuilder()
+	b.write("ALTER TABLE ")
+	b.table(q.table)
+	if q.cluster != "" {
+		b.write(" ON CLUSTER ")
+		b.ident(q.cluster)
+	}
+	switch {
+	case q.delete:
+		b.write(" DELETE")
+	case len(q.updates) > 0:
+		b.write(" UPDATE ")
+		for i, u := range q.updates {
+			if i > 0 {
+				b.write(", ")
+			}
+			b.ident(u.column)
+			b.write(" = ")
+			b.value(u.value)
+		}
+	default:
+		b.write(" ")
+		for i, action := range q.actions {
+			if i > 0 {
+				b.write(", ")
+			}
+			action.appendSQL(b)
+		}
+	}
+	if len(q.where) > 0 {
+		b.write(" WHERE ")
+		b.conditions(q.where)
+	}
+	b.settings(q.settings)
+	return b.result()
+}
+
+var _ Builder = (*AlterBuilder)(nil)
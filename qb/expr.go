@@ -0,0 +1,219 @@
+package qb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Expr is an SQL expression, e.g. a column, a condition or a subquery.
+// Where an expression is expected, strings are column names and other Go values are query parameters.
+type Expr interface {
+	appendSQL(b *builder)
+}
+
+type exprFunc func(b *builder)
+
+func (f exprFunc) appendSQL(b *builder) {
+	f(b)
+}
+
+// Col returns a possibly qualified column, e.g. Col("t", "id") for t.id.
+func Col(parts ...string) Expr {
+	return exprFunc(func(b *builder) {
+		b.ident(parts...)
+	})
+}
+
+// Raw returns an SQL expression written as is, with every ? replaced by the next argument.
+// Arguments are query parameters unless they are expressions. Question marks within quotes are kept.
+func Raw(sql string, args ...any) Expr {
+	return exprFunc(func(b *builder) {
+		next := 0
+		for i := 0; i < len(sql); i++ {
+			switch c := sql[i]; c {
+			case '\'', '"', '`':
+				end := i + 1
+				for ; end < len(sql) && sql[end] != c; end++ {
+					if sql[end] == '\\' {
+						end++
+					}
+				}
+				if end >= len(sql) {
+					end = len(sql) - 1
+				}
+				b.write(sql[i : end+1])
+				i = end
+			case '?':
+				if next == len(args) {
+					b.fail(fmt.Errorf("qb: not enough arguments for %q", sql))
+					return
+				}
+				b.value(args[next])
+				next++
+			default:
+				b.sql.WriteByte(c)
+			}
+		}
+		if next != len(args) {
+			b.fail(fmt.Errorf("qb: too many arguments for %q", sql))
+		}
+	})
+}
+
+// Param returns v as a query parameter of the type inferred from it, see TypeOf.
+func Param(v any) Expr {
+	return exprFunc(func(b *builder) {
+		b.param(v, "")
+	})
+}
+
+// TypedParam returns v as a query parameter of chType, e.g. for nil values or to compare with a narrower type.
+func TypedParam(v any, chType string) Expr {
+	return exprFunc(func(b *builder) {
+		b.param(v, chType)
+	})
+}
+
+// As returns the column or expression with an alias.
+func As(column any, alias string) Expr {
+	return exprFunc(func(b *builder) {
+		b.column(column)
+		b.write(" AS ")
+		b.ident(alias)
+	})
+}
+
+func binary(column any, op string, value any) Expr {
+	return exprFunc(func(b *builder) {
+		b.column(column)
+		b.write(" ", op, " ")
+		b.value(value)
+	})
+}
+
+// Eq returns column = value.
+func Eq(column, value any) Expr {
+	return binary(column, "=", value)
+}
+
+// NotEq returns column != value.
+func NotEq(column, value any) Expr {
+	return binary(column, "!=", value)
+}
+
+// Lt returns column < value.
+func Lt(column, value any) Expr {
+	return binary(column, "<", value)
+}
+
+// Lte returns column <= value.
+func Lte(column, value any) Expr {
+	return binary(column, "<=", value)
+}
+
+// Gt returns column > value.
+func Gt(column, value any) Expr {
+	return binary(column, ">", value)
+}
+
+// Gte returns column >= value.
+func Gte(column, value any) Expr {
+	return binary(column, ">=", value)
+}
+
+// Like returns column LIKE pattern.
+func Like(column any, pattern any) Expr {
+	return binary(column, "LIKE", pattern)
+}
+
+// ILike returns column ILIKE pattern.
+func ILike(column any, pattern any) Expr {
+	return binary(column, "ILIKE", pattern)
+}
+
+// In returns column IN values, values is a slice sent as an Array parameter or a subquery.
+func In(column, values any) Expr {
+	return binary(column, "IN", values)
+}
+
+// NotIn returns column NOT IN values.
+func NotIn(column, values any) Expr {
+	return binary(column, "NOT IN", values)
+}
+
+// GlobalIn returns column GLOBAL IN values, which runs a subquery once on the initiator
+// instead of on every shard of a Distributed table.
+func GlobalIn(column, values any) Expr {
+	return binary(column, "GLOBAL IN", values)
+}
+
+// GlobalNotIn returns column GLOBAL NOT IN values.
+func GlobalNotIn(column, values any) Expr {
+	return binary(column, "GLOBAL NOT IN", values)
+}
+
+// Between returns column BETWEEN from AND to.
+func Between(column, from, to any) Expr {
+	return exprFunc(func(b *builder) {
+		b.column(column)
+		b.write(" BETWEEN ")
+		b.value(from)
+		b.write(" AND ")
+		b.value(to)
+	})
+}
+
+// IsNull returns column IS NULL.
+func IsNull(column any) Expr {
+	return exprFunc(func(b *builder) {
+		b.column(column)
+		b.write(" IS NULL")
+	})
+}
+
+// IsNotNull returns column IS NOT NULL.
+func IsNotNull(column any) Expr {
+	return exprFunc(func(b *builder) {
+		b.column(column)
+		b.write(" IS NOT NULL")
+	})
+}
+
+func join(op string, exprs []Expr) Expr {
+	return exprFunc(func(b *builder) {
+		switch len(exprs) {
+		case 0:
+			b.fail(fmt.Errorf("qb: %s without conditions", strings.TrimSpace(op)))
+		case 1:
+			exprs[0].appendSQL(b)
+		default:
+			b.write("(")
+			for i, expr := range exprs {
+				if i > 0 {
+					b.write(op)
+				}
+				expr.appendSQL(b)
+			}
+			b.write(")")
+		}
+	})
+}
+
+// And returns the conjunction of the conditions.
+func And(exprs ...Expr) Expr {
+	return join(" AND ", exprs)
+}
+
+// Or returns the disjunction of the conditions.
+func Or(exprs ...Expr) Expr {
+	return join(" OR ", exprs)
+}
+
+// Not returns the negation of the condition.
+func Not(expr Expr) Expr {
+	return exprFunc(func(b *builder) {
+		b.write("NOT (")
+		expr.appendSQL(b)
+		b.write(")")
+	})
+}
@@ -0,0 +1,62 @@
+package qb
+
+import (
+	"github.com/ClickHouse/clickhouse-go/v2"
+)
+
+// InsertBuilder builds an INSERT statement, either for a batch, e.g. conn.PrepareBatch(ctx, query),
+// or from a SELECT.
+type InsertBuilder struct {
+	table    string
+	columns  []string
+	settings []setting
+	query    *SelectBuilder
+}
+
+// Insert starts an INSERT INTO the table, a database may be given as in db.table.
+func Insert(table string) *InsertBuilder {
+	return &InsertBuilder{table: table}
+}
+
+// Columns sets the columns to insert, all columns if none are given.
+func (q *InsertBuilder) Columns(columns ...string) *InsertBuilder {
+	q.columns = columns
+	return q
+}
+
+// Settings adds a setting to the SETTINGS clause, value is a number, bool or string.
+func (q *InsertBuilder) Settings(name string, value any) *InsertBuilder {
+	q.settings = append(q.settings, setting{name: name, value: value})
+	return q
+}
+
+// Select inserts the result of the query.
+func (q *InsertBuilder) Select(query *SelectBuilder) *InsertBuilder {
+	q.query = query
+	return q
+}
+
+// Build returns the statement and the values of its query parameters.
+func (q *InsertBuilder) Build() (string, clickhouse.Parameters, error) {
+	b := newBuilder()
+	b.write("INSERT INTO ")
+	b.table(q.table)
+	if len(q.columns) > 0 {
+		b.write(" (")
+		for i, c := range q.columns {
+			if i > 0 {
+				b.write(", ")
+			}
+			b.ident(c)
+		}
+		b.write(")")
+	}
+	b.settings(q.settings)
+	if q.query != nil {
+		b.write(" ")
+		q.query.build(b)
+	}
+	return b.result()
+}
+
+var _ Builder = (*InsertBuilder)(nil)
@@ -0,0 +1,188 @@
+// Package qb builds SELECT, INSERT and ALTER statements for ClickHouse without formatting values into the SQL.
+// Values are sent as typed query parameters, e.g. {p1:UInt64}, whose types are inferred from the Go values:
+//
+//	query, params, err := qb.Select("id", "name").
+//		From("events").
+//		Final().
+//		Prewhere(qb.Eq("type", "click")).
+//		Where(qb.GlobalIn("user_id", ids)).
+//		Limit(10).
+//		Build()
+//	if err != nil {
+//		return err
+//	}
+//	rows, err := conn.Query(clickhouse.Context(ctx, clickhouse.WithParameters(params)), query)
+//
+// Identifiers are quoted with backquotes unless they only consist of letters, digits and underscores.
+package qb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/column"
+)
+
+// Builder is implemented by the statement builders of the package.
+type Builder interface {
+	// Build returns the statement and the values of its query parameters.
+	Build() (string, clickhouse.Parameters, error)
+}
+
+// builder accumulates the SQL of a statement and the parameters it refers to.
+type builder struct {
+	sql    strings.Builder
+	params clickhouse.Parameters
+	err    error
+}
+
+func newBuilder() *builder {
+	return &builder{params: clickhouse.Parameters{}}
+}
+
+func (b *builder) result() (string, clickhouse.Parameters, error) {
+	if b.err != nil {
+		return "", nil, b.err
+	}
+	return b.sql.String(), b.params, nil
+}
+
+func (b *builder) write(s ...string) {
+	for _, s := range s {
+		b.sql.WriteString(s)
+	}
+}
+
+func (b *builder) fail(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// ident writes a possibly qualified identifier, quoting every part as needed.
+func (b *builder) ident(parts ...string) {
+	for i, part := range parts {
+		if part == "" {
+			b.fail(fmt.Errorf("qb: empty identifier"))
+			return
+		}
+		if i > 0 {
+			b.write(".")
+		}
+		b.write(column.QuoteIdentifier(part))
+	}
+}
+
+// table writes a table name, a database may be given as in db.table.
+func (b *builder) table(name string) {
+	if db, table, ok := strings.Cut(name, "."); ok {
+		b.ident(db, table)
+		return
+	}
+	b.ident(name)
+}
+
+// column writes v as a column: strings are identifiers, "*" is written as is.
+func (b *builder) column(v any) {
+	switch v := v.(type) {
+	case Expr:
+		v.appendSQL(b)
+	case string:
+		if v == "*" {
+			b.write(v)
+			return
+		}
+		b.ident(v)
+	default:
+		b.fail(fmt.Errorf("qb: unsupported column %T, use a string or an Expr", v))
+	}
+}
+
+func (b *builder) columns(columns []any) {
+	for i, c := range columns {
+		if i > 0 {
+			b.write(", ")
+		}
+		b.column(c)
+	}
+}
+
+// value writes v as a query parameter unless it is an expression.
+func (b *builder) value(v any) {
+	if expr, ok := v.(Expr); ok {
+		expr.appendSQL(b)
+		return
+	}
+	b.param(v, "")
+}
+
+// param adds v as the next query parameter of chType, or of the type inferred from v if chType is empty.
+func (b *builder) param(v any, chType string) {
+	if chType == "" {
+		var err error
+		if chType, err = TypeOf(v); err != nil {
+			b.fail(err)
+			return
+		}
+	}
+	value, err := clickhouse.FormatQueryParameter(chType, v, time.UTC)
+	if err != nil {
+		b.fail(fmt.Errorf("qb: %w", err))
+		return
+	}
+	name := fmt.Sprintf("p%d", len(b.params)+1)
+	b.params[name] = value
+	b.write("{", name, ":", chType, "}")
+}
+
+// conditions writes exprs joined by AND.
+func (b *builder) conditions(exprs []Expr) {
+	if len(exprs) == 1 {
+		exprs[0].appendSQL(b)
+		return
+	}
+	And(exprs...).appendSQL(b)
+}
+
+// setting is a name = value pair of a SETTINGS clause.
+type setting struct {
+	name  string
+	value any
+}
+
+func (b *builder) settings(settings []setting) {
+	if len(settings) == 0 {
+		return
+	}
+	b.write(" SETTINGS ")
+	for i, s := range settings {
+		if i > 0 {
+			b.write(", ")
+		}
+		b.ident(s.name)
+		b.write(" = ")
+		b.literal(s.value)
+	}
+}
+
+// literal writes a number, bool or string as an SQL literal, for clauses that do not accept query parameters.
+func (b *builder) literal(v any) {
+	switch v := v.(type) {
+	case bool:
+		if v {
+			b.write("1")
+		} else {
+			b.write("0")
+		}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		b.write(fmt.Sprint(v))
+	case string:
+		b.write("'", literalReplacer.Replace(v), "'")
+	default:
+		b.fail(fmt.Errorf("qb: unsupported literal %T", v))
+	}
+}
+
+var literalReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
@@ -0,0 +1,167 @@
+package qb
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelect(t *testing.T) {
+	since := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	query, params, err := Select("id", "user id", As(Raw("count()"), "c")).
+		From("analytics.events").
+		Final().
+		Sample(0.1).
+		ArrayJoin("tags").
+		Prewhere(Eq("type", "click")).
+		Where(Gte("ts", since), Or(GlobalIn("user_id", []uint64{1, 2}), IsNull("ref"))).
+		GroupBy("id", "user id").
+		Having(Gt(Raw("count()"), 10)).
+		OrderBy(Desc("c"), Asc("id")).
+		LimitBy(2, "id").
+		Limit(100).
+		Offset(10).
+		Settings("max_threads", 4).
+		Settings("log_comment", "it's").
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT id, `user id`, count() AS c FROM analytics.events FINAL SAMPLE 0.1 ARRAY JOIN tags"+
+		" PREWHERE type = {p1:String} WHERE (ts >= {p2:DateTime64(9, 'UTC')} AND (user_id GLOBAL IN {p3:Array(UInt64)} OR ref IS NULL))"+
+		" GROUP BY id, `user id` HAVING count() > {p4:Int64} ORDER BY c DESC, id ASC LIMIT 2 BY id LIMIT 100 OFFSET 10"+
+		" SETTINGS max_threads = 4, log_comment = 'it\\'s'", query)
+	assert.Equal(t, clickhouse.Parameters{
+		"p1": "click",
+		"p2": "2024-05-01 12:00:00.000000000",
+		"p3": "[1,2]",
+		"p4": "10",
+	}, params)
+}
+
+func TestSelectSubqueryAndFill(t *testing.T) {
+	sub := Select("user_id").From("banned").Where(Eq("reason", "spam"))
+	query, params, err := Select().
+		From(Select("day", "n").From("daily").Where(NotIn("user_id", sub))).
+		LeftArrayJoin(Col("n", "items")).
+		OrderBy(Asc("day").WithFill(1, 10, nil)).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM (SELECT day, n FROM daily WHERE user_id NOT IN (SELECT user_id FROM banned WHERE reason = {p1:String}))"+
+		" LEFT ARRAY JOIN n.items ORDER BY day ASC WITH FILL FROM {p2:Int64} TO {p3:Int64}", query)
+	assert.Equal(t, clickhouse.Parameters{"p1": "spam", "p2": "1", "p3": "10"}, params)
+}
+
+func TestRaw(t *testing.T) {
+	query, params, err := Select(Raw("toStartOfInterval(ts, INTERVAL ? SECOND) AS '?'", 60)).
+		Where(Raw("a = ? OR b = ?", Col("c"), TypedParam(nil, "Nullable(String)"))).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT toStartOfInterval(ts, INTERVAL {p1:Int64} SECOND) AS '?' WHERE a = c OR b = {p2:Nullable(String)}", query)
+	assert.Equal(t, clickhouse.Parameters{"p1": "60", "p2": `\N`}, params)
+
+	_, _, err = Select(Raw("?")).Build()
+	assert.ErrorContains(t, err, "not enough arguments")
+	_, _, err = Select(Raw("1", 2)).Build()
+	assert.ErrorContains(t, err, "too many arguments")
+	_, _, err = Select().Where(Eq("a", nil)).Build()
+	assert.ErrorContains(t, err, "use TypedParam")
+	_, _, err = Select(1).Build()
+	assert.ErrorContains(t, err, "unsupported column")
+}
+
+func TestInsert(t *testing.T) {
+	query, params, err := Insert("db.target").Columns("id", "value-1").Build()
+	require.NoError(t, err)
+	assert.Equal(t, "INSERT INTO db.target (id, `value-1`)", query)
+	assert.Empty(t, params)
+
+	query, params, err = Insert("target").
+		Settings("async_insert", true).
+		Select(Select("id").From("source").Where(Lt("id", uint8(5)))).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, "INSERT INTO target SETTINGS async_insert = 1 SELECT id FROM source WHERE id < {p1:UInt8}", query)
+	assert.Equal(t, clickhouse.Parameters{"p1": "5"}, params)
+}
+
+func TestAlter(t *testing.T) {
+	query, params, err := Alter("events").
+		OnCluster("main").
+		Update("name", "it's").
+		Update("n", Raw("n + ?", 1)).
+		Where(Eq("id", int32(7))).
+		Settings("mutations_sync", 2).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, "ALTER TABLE events ON CLUSTER main UPDATE name = {p1:String}, n = n + {p2:Int64} WHERE id = {p3:Int32} SETTINGS mutations_sync = 2", query)
+	assert.Equal(t, clickhouse.Parameters{"p1": "it's", "p2": "1", "p3": "7"}, params)
+
+	query, _, err = Alter("events").Delete().Where(Between("ts", 1, 2)).Build()
+	require.NoError(t, err)
+	assert.Equal(t, "ALTER TABLE events DELETE WHERE ts BETWEEN {p1:Int64} AND {p2:Int64}", query)
+
+	query, _, err = Alter("events").AddColumn("score", "Float64").DropColumn("old col").Build()
+	require.NoError(t, err)
+	assert.Equal(t, "ALTER TABLE events ADD COLUMN score Float64, DROP COLUMN `old col`", query)
+
+	for _, q := range []*AlterBuilder{
+		Alter("events").Delete(),
+		Alter("events").Delete().Update("a", 1).Where(Eq("a", 1)),
+		Alter("events").Where(Eq("a", 1)),
+		Alter("events"),
+	} {
+		_, _, err := q.Build()
+		assert.Error(t, err)
+	}
+}
+
+func TestTypeOf(t *testing.T) {
+	type point struct {
+		X float64 `ch:"x"`
+		Y float64
+		z float64
+	}
+	str := "a"
+	testCases := []struct {
+		value    any
+		expected string
+	}{
+		{"a", "String"},
+		{[]byte("a"), "String"},
+		{true, "Bool"},
+		{1, "Int64"},
+		{int16(1), "Int16"},
+		{uint(1), "UInt64"},
+		{float32(1), "Float32"},
+		{time.Now(), "DateTime64(9, 'UTC')"},
+		{uuid.New(), "UUID"},
+		{net.ParseIP("127.0.0.1"), "IPv4"},
+		{net.ParseIP("::1"), "IPv6"},
+		{decimal.RequireFromString("12.345"), "Decimal(38, 3)"},
+		{&str, "Nullable(String)"},
+		{(*int8)(nil), "Nullable(Int8)"},
+		{[]string{}, "Array(String)"},
+		{[][]*int{}, "Array(Array(Nullable(Int64)))"},
+		{[]any{"a", "b"}, "Array(String)"},
+		{[]decimal.Decimal{decimal.New(1, -2)}, "Array(Decimal(38, 2))"},
+		{map[string]uint32{}, "Map(String, UInt32)"},
+		{map[string]any{"a": 1}, "Map(String, Int64)"},
+		{point{}, "Tuple(x Float64, Y Float64)"},
+		{&[]int{}, "Array(Int64)"},
+	}
+	for _, tc := range testCases {
+		actual, err := TypeOf(tc.value)
+		require.NoError(t, err, "%T", tc.value)
+		assert.Equal(t, tc.expected, actual, "%T", tc.value)
+	}
+
+	for _, v := range []any{nil, []any{}, []any{"a", 1}, []decimal.Decimal{}, struct{}{}, make(chan int)} {
+		_, err := TypeOf(v)
+		assert.Error(t, err, "%T", v)
+	}
+}
@@ -0,0 +1,263 @@
+package qb
+
+import (
+	"strconv"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+)
+
+// SelectBuilder builds a SELECT statement. Used as an expression, e.g. in In or From, it is a subquery.
+type SelectBuilder struct {
+	columns       []any
+	distinct      bool
+	from          any
+	final         bool
+	sample        string
+	arrayJoin     []any
+	leftArrayJoin bool
+	prewhere      []Expr
+	where         []Expr
+	groupBy       []any
+	having        []Expr
+	orderBy       []Order
+	limitBy       *limitBy
+	limit         *uint64
+	offset        *uint64
+	settings      []setting
+}
+
+type limitBy struct {
+	limit   uint64
+	columns []any
+}
+
+// Select starts a SELECT of the given columns or expressions, all columns if none are given.
+func Select(columns ...any) *SelectBuilder {
+	return &SelectBuilder{columns: columns}
+}
+
+// Distinct selects distinct rows.
+func (q *SelectBuilder) Distinct() *SelectBuilder {
+	q.distinct = true
+	return q
+}
+
+// From sets the table, db.table, subquery or table function expression to select from.
+func (q *SelectBuilder) From(table any) *SelectBuilder {
+	q.from = table
+	return q
+}
+
+// Final merges the data of ReplacingMergeTree and similar engines while reading.
+func (q *SelectBuilder) Final() *SelectBuilder {
+	q.final = true
+	return q
+}
+
+// Sample reads a sample of the data, a ratio such as 0.1 or an approximate number of rows such as 10000.
+func (q *SelectBuilder) Sample(k float64) *SelectBuilder {
+	q.sample = strconv.FormatFloat(k, 'f', -1, 64)
+	return q
+}
+
+// ArrayJoin unfolds the rows by the given arrays, rows with empty arrays are dropped.
+func (q *SelectBuilder) ArrayJoin(arrays ...any) *SelectBuilder {
+	q.arrayJoin, q.leftArrayJoin = arrays, false
+	return q
+}
+
+// LeftArrayJoin unfolds the rows by the given arrays, keeping rows with empty arrays.
+func (q *SelectBuilder) LeftArrayJoin(arrays ...any) *SelectBuilder {
+	q.arrayJoin, q.leftArrayJoin = arrays, true
+	return q
+}
+
+// Prewhere adds conditions to the PREWHERE clause, they are joined by AND.
+func (q *SelectBuilder) Prewhere(conditions ...Expr) *SelectBuilder {
+	q.prewhere = append(q.prewhere, conditions...)
+	return q
+}
+
+// Where adds conditions to the WHERE clause, they are joined by AND.
+func (q *SelectBuilder) Where(conditions ...Expr) *SelectBuilder {
+	q.where = append(q.where, conditions...)
+	return q
+}
+
+// GroupBy adds columns or expressions to the GROUP BY clause.
+func (q *SelectBuilder) GroupBy(columns ...any) *SelectBuilder {
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+// Having adds conditions to the HAVING clause, they are joined by AND.
+func (q *SelectBuilder) Having(conditions ...Expr) *SelectBuilder {
+	q.having = append(q.having, conditions...)
+	return q
+}
+
+// OrderBy adds terms to the ORDER BY clause, see Asc and Desc.
+func (q *SelectBuilder) OrderBy(terms ...Order) *SelectBuilder {
+	q.orderBy = append(q.orderBy, terms...)
+	return q
+}
+
+// LimitBy keeps at most limit rows for every distinct value of the columns.
+func (q *SelectBuilder) LimitBy(limit uint64, columns ...any) *SelectBuilder {
+	q.limitBy = &limitBy{limit: limit, columns: columns}
+	return q
+}
+
+// Limit returns at most limit rows.
+func (q *SelectBuilder) Limit(limit uint64) *SelectBuilder {
+	q.limit = &limit
+	return q
+}
+
+// Offset skips the first offset rows.
+func (q *SelectBuilder) Offset(offset uint64) *SelectBuilder {
+	q.offset = &offset
+	return q
+}
+
+// Settings adds a setting to the SETTINGS clause, value is a number, bool or string.
+func (q *SelectBuilder) Settings(name string, value any) *SelectBuilder {
+	q.settings = append(q.settings, setting{name: name, value: value})
+	return q
+}
+
+// Build returns the statement and the values of its query parameters.
+func (q *SelectBuilder) Build() (string, clickhouse.Parameters, error) {
+	b := newBuilder()
+	q.build(b)
+	return b.result()
+}
+
+func (q *SelectBuilder) appendSQL(b *builder) {
+	b.write("(")
+	q.build(b)
+	b.write(")")
+}
+
+func (q *SelectBuilder) build(b *builder) {
+	b.write("SELECT ")
+	if q.distinct {
+		b.write("DISTINCT ")
+	}
+	if len(q.columns) == 0 {
+		b.write("*")
+	}
+	b.columns(q.columns)
+	if q.from != nil {
+		b.write(" FROM ")
+		switch from := q.from.(type) {
+		case string:
+			b.table(from)
+		default:
+			b.column(from)
+		}
+	}
+	if q.final {
+		b.write(" FINAL")
+	}
+	if q.sample != "" {
+		b.write(" SAMPLE ", q.sample)
+	}
+	if len(q.arrayJoin) > 0 {
+		if q.leftArrayJoin {
+			b.write(" LEFT")
+		}
+		b.write(" ARRAY JOIN ")
+		b.columns(q.arrayJoin)
+	}
+	if len(q.prewhere) > 0 {
+		b.write(" PREWHERE ")
+		b.conditions(q.prewhere)
+	}
+	if len(q.where) > 0 {
+		b.write(" WHERE ")
+		b.conditions(q.where)
+	}
+	if len(q.groupBy) > 0 {
+		b.write(" GROUP BY ")
+		b.columns(q.groupBy)
+	}
+	if len(q.having) > 0 {
+		b.write(" HAVING ")
+		b.conditions(q.having)
+	}
+	if len(q.orderBy) > 0 {
+		b.write(" ORDER BY ")
+		for i, term := range q.orderBy {
+			if i > 0 {
+				b.write(", ")
+			}
+			term.appendSQL(b)
+		}
+	}
+	if q.limitBy != nil {
+		b.write(" LIMIT ", strconv.FormatUint(q.limitBy.limit, 10), " BY ")
+		b.columns(q.limitBy.columns)
+	}
+	if q.limit != nil {
+		b.write(" LIMIT ", strconv.FormatUint(*q.limit, 10))
+	}
+	if q.offset != nil {
+		b.write(" OFFSET ", strconv.FormatUint(*q.offset, 10))
+	}
+	b.settings(q.settings)
+}
+
+// Order is a term of an ORDER BY clause.
+type Order struct {
+	column any
+	desc   bool
+	fill   *fill
+}
+
+type fill struct {
+	from, to, step any
+}
+
+// Asc orders by the column or expression in ascending order.
+func Asc(column any) Order {
+	return Order{column: column}
+}
+
+// Desc orders by the column or expression in descending order.
+func Desc(column any) Order {
+	return Order{column: column, desc: true}
+}
+
+// WithFill fills the gaps between the values of the term with rows of default values.
+// from, to and step are optional, nil omits them. The step of a date or time is an interval such as
+// Raw("INTERVAL 1 DAY").
+func (o Order) WithFill(from, to, step any) Order {
+	o.fill = &fill{from: from, to: to, step: step}
+	return o
+}
+
+func (o Order) appendSQL(b *builder) {
+	b.column(o.column)
+	if o.desc {
+		b.write(" DESC")
+	} else {
+		b.write(" ASC")
+	}
+	if o.fill == nil {
+		return
+	}
+	b.write(" WITH FILL")
+	for _, part := range []struct {
+		keyword string
+		value   any
+	}{{" FROM ", o.fill.from}, {" TO ", o.fill.to}, {" STEP ", o.fill.step}} {
+		if part.value != nil {
+			b.write(part.keyword)
+			b.value(part.value)
+		}
+	}
+}
+
+var _ Builder = (*SelectBuilder)(nil)
+var _ Expr = (*SelectBuilder)(nil)
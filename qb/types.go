@@ -0,0 +1,218 @@
+package qb
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"net/netip"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	uuidType    = reflect.TypeOf(uuid.UUID{})
+	ipType      = reflect.TypeOf(net.IP{})
+	addrType    = reflect.TypeOf(netip.Addr{})
+	decimalType = reflect.TypeOf(decimal.Decimal{})
+	bigIntType  = reflect.TypeOf(big.Int{})
+)
+
+// TypeOf returns the ClickHouse type a query parameter of v is declared as:
+//
+//	string, []byte            String
+//	bool                      Bool
+//	int8 … int64, int         Int8 … Int64, Int64
+//	uint8 … uint64, uint      UInt8 … UInt64, UInt64
+//	float32, float64          Float32, Float64
+//	time.Time                 DateTime64(9, 'UTC')
+//	uuid.UUID                 UUID
+//	net.IP, netip.Addr        IPv4 or IPv6
+//	decimal.Decimal           Decimal(38, S) or Decimal(76, S) with the scale S of the value
+//	*big.Int                  Int256
+//	[]T                       Array(T)
+//	map[K]V                   Map(K, V)
+//	struct                    Tuple(name T, ...), named by the `ch` tag or the field name
+//	*T                        Nullable(T), or T for Array, Map and Tuple
+//
+// Types of slices and maps of interfaces or decimals are inferred from their elements, which must all be
+// of the same type. Use TypedParam for nil values and other types.
+func TypeOf(v any) (string, error) {
+	if v == nil {
+		return "", fmt.Errorf("qb: cannot infer the type of nil, use TypedParam")
+	}
+	return typeOf(reflect.TypeOf(v), reflect.ValueOf(v))
+}
+
+// typeOf returns the type of values of typ, value is a value of typ or invalid if only the type is known.
+func typeOf(typ reflect.Type, value reflect.Value) (string, error) {
+	switch typ {
+	case timeType:
+		return "DateTime64(9, 'UTC')", nil
+	case uuidType:
+		return "UUID", nil
+	case ipType:
+		if value.IsValid() && value.Interface().(net.IP).To4() != nil {
+			return "IPv4", nil
+		}
+		return "IPv6", nil
+	case addrType:
+		if value.IsValid() && value.Interface().(netip.Addr).Is4() {
+			return "IPv4", nil
+		}
+		return "IPv6", nil
+	case decimalType:
+		if !value.IsValid() {
+			return "", fmt.Errorf("qb: cannot infer the scale of %s, use TypedParam", typ)
+		}
+		return decimalTypeOf(value.Interface().(decimal.Decimal))
+	case bigIntType:
+		return "Int256", nil
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return "String", nil
+	case reflect.Bool:
+		return "Bool", nil
+	case reflect.Int, reflect.Int64:
+		return "Int64", nil
+	case reflect.Int8:
+		return "Int8", nil
+	case reflect.Int16:
+		return "Int16", nil
+	case reflect.Int32:
+		return "Int32", nil
+	case reflect.Uint, reflect.Uint64:
+		return "UInt64", nil
+	case reflect.Uint8:
+		return "UInt8", nil
+	case reflect.Uint16:
+		return "UInt16", nil
+	case reflect.Uint32:
+		return "UInt32", nil
+	case reflect.Float32:
+		return "Float32", nil
+	case reflect.Float64:
+		return "Float64", nil
+	case reflect.Pointer:
+		var elem reflect.Value
+		if value.IsValid() && !value.IsNil() {
+			elem = value.Elem()
+		}
+		elemType, err := typeOf(typ.Elem(), elem)
+		if err != nil {
+			return "", err
+		}
+		return nullable(elemType), nil
+	case reflect.Interface:
+		if !value.IsValid() || value.IsNil() {
+			return "", fmt.Errorf("qb: cannot infer the type of %s, use TypedParam", typ)
+		}
+		return typeOf(value.Elem().Type(), value.Elem())
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return "String", nil
+		}
+		elemType, err := elementsTypeOf(typ.Elem(), value, func(i int) reflect.Value { return value.Index(i) })
+		if err != nil {
+			return "", err
+		}
+		return "Array(" + elemType + ")", nil
+	case reflect.Map:
+		var keys []reflect.Value
+		if value.IsValid() {
+			keys = value.MapKeys()
+		}
+		keyType, err := elementsTypeOf(typ.Key(), value, func(i int) reflect.Value { return keys[i] })
+		if err != nil {
+			return "", err
+		}
+		valueType, err := elementsTypeOf(typ.Elem(), value, func(i int) reflect.Value { return value.MapIndex(keys[i]) })
+		if err != nil {
+			return "", err
+		}
+		return "Map(" + keyType + ", " + valueType + ")", nil
+	case reflect.Struct:
+		var elems []string
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := field.Name
+			if tag := field.Tag.Get("ch"); tag != "" {
+				name = tag
+			}
+			var fieldValue reflect.Value
+			if value.IsValid() {
+				fieldValue = value.Field(i)
+			}
+			fieldType, err := typeOf(field.Type, fieldValue)
+			if err != nil {
+				return "", err
+			}
+			elems = append(elems, name+" "+fieldType)
+		}
+		if len(elems) == 0 {
+			return "", fmt.Errorf("qb: cannot infer the type of %s without exported fields", typ)
+		}
+		return "Tuple(" + strings.Join(elems, ", ") + ")", nil
+	}
+	return "", fmt.Errorf("qb: cannot infer the type of %s, use TypedParam", typ)
+}
+
+// elementsTypeOf returns the type of the elements of a slice or map, from the element type if it is enough
+// and from the elements otherwise.
+func elementsTypeOf(typ reflect.Type, container reflect.Value, elem func(i int) reflect.Value) (string, error) {
+	if chType, err := typeOf(typ, reflect.Value{}); err == nil {
+		return chType, nil
+	}
+	if !container.IsValid() || container.Len() == 0 {
+		return typeOf(typ, reflect.Value{})
+	}
+	var chType string
+	for i := 0; i < container.Len(); i++ {
+		elemType, err := typeOf(typ, elem(i))
+		if err != nil {
+			return "", err
+		}
+		if i > 0 && elemType != chType {
+			return "", fmt.Errorf("qb: elements of both type %s and %s, use TypedParam", chType, elemType)
+		}
+		chType = elemType
+	}
+	return chType, nil
+}
+
+func decimalTypeOf(d decimal.Decimal) (string, error) {
+	scale := 0
+	if exp := d.Exponent(); exp < 0 {
+		scale = int(-exp)
+	}
+	digits := len(new(big.Int).Abs(d.Coefficient()).String())
+	if exp := d.Exponent(); exp > 0 {
+		digits += int(exp)
+	}
+	switch {
+	case digits <= 38 && scale <= 38:
+		return fmt.Sprintf("Decimal(38, %d)", scale), nil
+	case digits <= 76 && scale <= 76:
+		return fmt.Sprintf("Decimal(76, %d)", scale), nil
+	}
+	return "", fmt.Errorf("qb: %s does not fit into Decimal(76, S)", d)
+}
+
+// nullable wraps chType into Nullable unless it cannot be, i.e. for composite types.
+func nullable(chType string) string {
+	for _, prefix := range []string{"Array(", "Map(", "Tuple(", "Nullable("} {
+		if strings.HasPrefix(chType, prefix) {
+			return chType
+		}
+	}
+	return "Nullable(" + chType + ")"
+}
@@ -2,6 +2,7 @@ package clickhouse
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
 	"time"
 
@@ -27,9 +28,18 @@ func bindQueryOrAppendParameters(paramsProtocolSupport bool, options *QueryOptio
 		len(args) > 0 &&
 		hasQueryParamsRe.MatchString(query) {
 		options.parameters = make(Parameters, len(args))
+		types := queryParameterTypes(query)
 		for _, a := range args {
 			switch p := a.(type) {
 			case driver.NamedValue:
+				if chType, ok := types[p.Name]; ok {
+					strVal, err := FormatQueryParameter(chType, p.Value, timezone)
+					if err != nil {
+						return "", fmt.Errorf("query parameter %s: %w", p.Name, err)
+					}
+					options.parameters[p.Name] = strVal
+					continue
+				}
 				if str, ok := p.Value.(string); ok {
 					options.parameters[p.Name] = str
 					continue
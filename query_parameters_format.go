@@ -0,0 +1,544 @@
+package clickhouse
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"net/netip"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	chproto "github.com/ClickHouse/ch-go/proto"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
+)
+
+var (
+	// escapedReplacer escapes a top-level parameter value, which the server parses in the TSV escaped format
+	escapedReplacer = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`, "\x00", `\0`, "\b", `\b`, "\f", `\f`)
+	// quotedReplacer escapes a string quoted within an Array, Map or Tuple parameter value
+	quotedReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\t", `\t`, "\n", `\n`, "\r", `\r`, "\x00", `\0`, "\b", `\b`, "\f", `\f`)
+
+	numberParameterRe = regexp.MustCompile(`^[+-]?(?:(?:\d+(?:\.\d*)?|\.\d+)(?:[eE][+-]?\d+)?|(?i:inf|nan))$`)
+	enumParameterRe   = regexp.MustCompile(`'((?:[^'\\]|\\.)*)'\s*=\s*(-?\d+)`)
+)
+
+// queryParameterTypes returns the declared type of every {name:Type} placeholder in query.
+func queryParameterTypes(query string) map[string]string {
+	types := make(map[string]string)
+	for i := 0; i < len(query); i++ {
+		switch query[i] {
+		case '\'':
+			i = skipQuoted(query, i)
+		case '{':
+			name, chType, end, ok := parseQueryParameter(query, i)
+			if ok {
+				types[name] = chType
+				i = end
+			}
+		}
+	}
+	return types
+}
+
+// parseQueryParameter parses the placeholder starting at the brace at start, end is the index of the closing brace.
+func parseQueryParameter(query string, start int) (name, chType string, end int, ok bool) {
+	i := start + 1
+	for i < len(query) && query[i] == ' ' {
+		i++
+	}
+	nameStart := i
+	for i < len(query) && (query[i] == '_' || query[i] >= 'a' && query[i] <= 'z' || query[i] >= 'A' && query[i] <= 'Z' || query[i] >= '0' && query[i] <= '9') {
+		i++
+	}
+	name = query[nameStart:i]
+	for i < len(query) && query[i] == ' ' {
+		i++
+	}
+	if name == "" || i == len(query) || query[i] != ':' {
+		return "", "", 0, false
+	}
+
+	typeStart, depth := i+1, 0
+	for i = typeStart; i < len(query); i++ {
+		switch query[i] {
+		case '\'':
+			i = skipQuoted(query, i)
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case '}':
+			if depth == 0 {
+				chType = strings.TrimSpace(query[typeStart:i])
+				return name, chType, i, chType != ""
+			}
+		}
+	}
+	return "", "", 0, false
+}
+
+// skipQuoted returns the index of the quote closing the string literal starting at start.
+func skipQuoted(s string, start int) int {
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '\'':
+			return i
+		}
+	}
+	return len(s)
+}
+
+// splitTypeArgs splits the arguments of a type such as Map(String, Array(UInt8)), ignoring commas within
+// brackets and quotes.
+func splitTypeArgs(args string) []string {
+	var (
+		parts []string
+		depth int
+		start int
+	)
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case '\'':
+			i = skipQuoted(args, i)
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(args[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if rest := strings.TrimSpace(args[start:]); rest != "" {
+		parts = append(parts, rest)
+	}
+	return parts
+}
+
+// splitType returns the name and arguments of a type, e.g. DateTime64 and [3, 'UTC'] for DateTime64(3, 'UTC').
+func splitType(chType string) (string, []string) {
+	chType = strings.TrimSpace(chType)
+	open := strings.IndexByte(chType, '(')
+	if open == -1 || !strings.HasSuffix(chType, ")") {
+		return chType, nil
+	}
+	return strings.TrimSpace(chType[:open]), splitTypeArgs(chType[open+1 : len(chType)-1])
+}
+
+// FormatQueryParameter serializes v into the text the server parses a {name:Type} query parameter of chType from,
+// i.e. a value for Parameters. Top-level values are written in the escaped format, elements of Array, Map and
+// Tuple values are quoted. A Go string passed for a type other than a string or enum type is sent as is,
+// i.e. it must already be in the server format. DateTime values are written in tz unless chType has a timezone.
+func FormatQueryParameter(chType string, v any, tz *time.Location) (string, error) {
+	return (&queryParameterFormatter{tz: tz}).format(chType, v, false)
+}
+
+type queryParameterFormatter struct {
+	tz *time.Location
+}
+
+func (f *queryParameterFormatter) format(chType string, v any, nested bool) (string, error) {
+	if valuer, ok := v.(driver.Valuer); ok && !isNilParameter(v) {
+		// e.g. sql.NullString, decimal.Decimal or uuid.UUID
+		val, err := valuer.Value()
+		if err != nil {
+			return "", err
+		}
+		return f.format(chType, val, nested)
+	}
+
+	name, args := splitType(chType)
+	switch name {
+	case "LowCardinality", "SimpleAggregateFunction":
+		if len(args) == 0 {
+			break
+		}
+		return f.format(args[len(args)-1], v, nested)
+	case "Nullable":
+		if len(args) != 1 {
+			break
+		}
+		if isNilParameter(v) {
+			if nested {
+				return "NULL", nil
+			}
+			return `\N`, nil
+		}
+		return f.format(args[0], v, nested)
+	}
+
+	if isNilParameter(v) {
+		return "", fmt.Errorf("nil value for query parameter of type %s", chType)
+	}
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+	v = value.Interface()
+
+	if s, ok := v.(string); ok && !nested && !isStringParameterType(name) {
+		// already in the server format
+		return s, nil
+	}
+
+	switch {
+	case name == "String" || name == "FixedString":
+		s, err := stringParameter(v)
+		if err != nil {
+			return "", err
+		}
+		return f.quote(s, nested), nil
+	case strings.HasPrefix(name, "Enum"):
+		return f.formatEnum(chType, args, v, nested)
+	case name == "Bool":
+		return formatBoolParameter(v)
+	case strings.HasPrefix(name, "Int"), strings.HasPrefix(name, "UInt"), strings.HasPrefix(name, "Float"), strings.HasPrefix(name, "Decimal"):
+		return formatNumberParameter(chType, v)
+	case name == "Date" || name == "Date32":
+		if t, ok := v.(time.Time); ok {
+			return f.quote(t.Format("2006-01-02"), nested), nil
+		}
+	case name == "DateTime" || name == "DateTime64":
+		if t, ok := v.(time.Time); ok {
+			return f.formatDateTime(name, args, t, nested)
+		}
+	case name == "Time" || name == "Time64":
+		if d, ok := v.(time.Duration); ok {
+			return f.quote(formatTimeParameter(d, name, args), nested), nil
+		}
+	case name == "UUID" || name == "IPv4" || name == "IPv6":
+		switch v := v.(type) {
+		case net.IP:
+			return f.quote(v.String(), nested), nil
+		case netip.Addr:
+			return f.quote(v.String(), nested), nil
+		case fmt.Stringer:
+			return f.quote(v.String(), nested), nil
+		}
+	case name == "Array" && len(args) == 1:
+		return f.formatArray(chType, args[0], value)
+	case name == "Map" && len(args) == 2:
+		return f.formatMap(chType, args[0], args[1], value)
+	case name == "Tuple" && len(args) > 0:
+		return f.formatTuple(chType, args, value)
+	}
+
+	if s, ok := v.(string); ok {
+		return f.quote(s, nested), nil
+	}
+	return "", fmt.Errorf("unsupported query parameter value %T for type %s", v, chType)
+}
+
+func (f *queryParameterFormatter) quote(s string, nested bool) string {
+	if nested {
+		return "'" + quotedReplacer.Replace(s) + "'"
+	}
+	return escapedReplacer.Replace(s)
+}
+
+func (f *queryParameterFormatter) formatEnum(chType string, args []string, v any, nested bool) (string, error) {
+	var number int64
+	switch v := v.(type) {
+	case string:
+		return f.quote(v, nested), nil
+	case fmt.Stringer:
+		return f.quote(v.String(), nested), nil
+	case int, int8, int16, int32, int64:
+		number = reflect.ValueOf(v).Int()
+	default:
+		return "", fmt.Errorf("unsupported query parameter value %T for type %s", v, chType)
+	}
+
+	for _, arg := range args {
+		match := enumParameterRe.FindStringSubmatch(arg)
+		if match != nil && match[2] == strconv.FormatInt(number, 10) {
+			// the name is sent unescaped, it is escaped for the format below
+			name := strings.NewReplacer(`\'`, `'`, `\\`, `\`).Replace(match[1])
+			return f.quote(name, nested), nil
+		}
+	}
+	return "", fmt.Errorf("%d is not a value of %s", number, chType)
+}
+
+func (f *queryParameterFormatter) formatDateTime(name string, args []string, t time.Time, nested bool) (string, error) {
+	loc, scale := f.tz, 0
+	if name == "DateTime64" && len(args) > 0 {
+		var err error
+		if scale, err = strconv.Atoi(args[0]); err != nil || scale < 0 || scale > 9 {
+			return "", fmt.Errorf("invalid scale %q of DateTime64", args[0])
+		}
+		args = args[1:]
+	}
+	if len(args) > 0 {
+		var err error
+		if loc, err = time.LoadLocation(strings.Trim(args[0], "'")); err != nil {
+			return "", err
+		}
+	}
+	if loc != nil {
+		t = t.In(loc)
+	}
+
+	layout := "2006-01-02 15:04:05"
+	if scale > 0 {
+		layout += "." + strings.Repeat("0", scale)
+	}
+	return f.quote(t.Format(layout), nested), nil
+}
+
+func (f *queryParameterFormatter) formatArray(chType, elemType string, value reflect.Value) (string, error) {
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return "", fmt.Errorf("unsupported query parameter value %s for type %s", value.Type(), chType)
+	}
+	elems := make([]string, value.Len())
+	for i := range elems {
+		elem, err := f.format(elemType, value.Index(i).Interface(), true)
+		if err != nil {
+			return "", err
+		}
+		elems[i] = elem
+	}
+	return "[" + strings.Join(elems, ",") + "]", nil
+}
+
+func (f *queryParameterFormatter) formatMap(chType, keyType, valueType string, value reflect.Value) (string, error) {
+	if value.Kind() != reflect.Map {
+		return "", fmt.Errorf("unsupported query parameter value %s for type %s", value.Type(), chType)
+	}
+	entries := make([]string, 0, value.Len())
+	iter := value.MapRange()
+	for iter.Next() {
+		key, err := f.format(keyType, iter.Key().Interface(), true)
+		if err != nil {
+			return "", err
+		}
+		val, err := f.format(valueType, iter.Value().Interface(), true)
+		if err != nil {
+			return "", err
+		}
+		entries = append(entries, key+":"+val)
+	}
+	// sorted for a stable query text
+	sort.Strings(entries)
+	return "{" + strings.Join(entries, ",") + "}", nil
+}
+
+func (f *queryParameterFormatter) formatTuple(chType string, args []string, value reflect.Value) (string, error) {
+	names := make([]string, len(args))
+	types := make([]string, len(args))
+	for i, arg := range args {
+		types[i] = arg
+		// named elements, e.g. Tuple(a String, b UInt8)
+		if name, elemType, ok := strings.Cut(arg, " "); ok && !strings.Contains(name, "(") {
+			names[i], types[i] = strings.Trim(name, "`"), strings.TrimSpace(elemType)
+		}
+	}
+
+	elems := make([]any, len(args))
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		if value.Len() != len(args) {
+			return "", fmt.Errorf("query parameter of type %s expects %d elements, got %d", chType, len(args), value.Len())
+		}
+		for i := range elems {
+			elems[i] = value.Index(i).Interface()
+		}
+	case reflect.Map:
+		for i, name := range names {
+			elem := value.MapIndex(reflect.ValueOf(name))
+			if name == "" || !elem.IsValid() {
+				return "", fmt.Errorf("missing element %q for query parameter of type %s", name, chType)
+			}
+			elems[i] = elem.Interface()
+		}
+	case reflect.Struct:
+		fields := make(map[string]any, value.NumField())
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := field.Name
+			if tag := field.Tag.Get("ch"); tag != "" {
+				name = tag
+			}
+			fields[name] = value.Field(i).Interface()
+		}
+		for i, name := range names {
+			elem, ok := fields[name]
+			if name == "" || !ok {
+				return "", fmt.Errorf("missing element %q for query parameter of type %s", name, chType)
+			}
+			elems[i] = elem
+		}
+	default:
+		return "", fmt.Errorf("unsupported query parameter value %s for type %s", value.Type(), chType)
+	}
+
+	formatted := make([]string, len(elems))
+	for i, elem := range elems {
+		var err error
+		if formatted[i], err = f.format(types[i], elem, true); err != nil {
+			return "", err
+		}
+	}
+	return "(" + strings.Join(formatted, ",") + ")", nil
+}
+
+func isNilParameter(v any) bool {
+	if v == nil {
+		return true
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Interface:
+		return value.IsNil()
+	}
+	return false
+}
+
+func isStringParameterType(name string) bool {
+	return name == "String" || name == "FixedString" || strings.HasPrefix(name, "Enum")
+}
+
+func stringParameter(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case fmt.Stringer:
+		return v.String(), nil
+	}
+	if value := reflect.ValueOf(v); value.Kind() == reflect.String {
+		return value.String(), nil
+	}
+	return "", fmt.Errorf("unsupported query parameter value %T for type String", v)
+}
+
+func formatBoolParameter(v any) (string, error) {
+	switch v := v.(type) {
+	case bool:
+		return strconv.FormatBool(v), nil
+	case string:
+		switch strings.ToLower(v) {
+		case "true", "1":
+			return "true", nil
+		case "false", "0":
+			return "false", nil
+		}
+	}
+	return "", fmt.Errorf("unsupported query parameter value %v for type Bool", v)
+}
+
+func formatNumberParameter(chType string, v any) (string, error) {
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Float32:
+		return formatFloatParameter(value.Float(), 32), nil
+	case reflect.Float64:
+		return formatFloatParameter(value.Float(), 64), nil
+	case reflect.Bool:
+		if value.Bool() {
+			return "1", nil
+		}
+		return "0", nil
+	}
+
+	var s string
+	switch v := v.(type) {
+	case big.Int:
+		return v.String(), nil
+	case chcol.Decimal64:
+		return formatDecimalParameter(big.NewInt(v.Value), v.Scale), nil
+	case chcol.Decimal128:
+		return formatDecimalParameter(int128ToBigInt(v.Value), v.Scale), nil
+	case string:
+		s = v
+	case fmt.Stringer:
+		// e.g. decimal.Decimal
+		s = v.String()
+	default:
+		return "", fmt.Errorf("unsupported query parameter value %T for type %s", v, chType)
+	}
+	if !numberParameterRe.MatchString(s) {
+		return "", fmt.Errorf("%q is not a valid value for query parameter of type %s", s, chType)
+	}
+	return s, nil
+}
+
+// formatDecimalParameter formats the unscaled value of a decimal with scale fractional digits, e.g. -0.05.
+func formatDecimalParameter(unscaled *big.Int, scale uint8) string {
+	sign := ""
+	if unscaled.Sign() < 0 {
+		sign, unscaled = "-", new(big.Int).Neg(unscaled)
+	}
+	digits := unscaled.String()
+	if scale == 0 {
+		return sign + digits
+	}
+	if len(digits) <= int(scale) {
+		digits = strings.Repeat("0", int(scale)-len(digits)+1) + digits
+	}
+	point := len(digits) - int(scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// int128ToBigInt converts a two's complement 128-bit integer.
+func int128ToBigInt(v chproto.Int128) *big.Int {
+	n := new(big.Int).SetUint64(v.High)
+	n.Lsh(n, 64).Or(n, new(big.Int).SetUint64(v.Low))
+	if int64(v.High) < 0 {
+		n.Sub(n, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+	return n
+}
+
+func formatFloatParameter(v float64, bitSize int) string {
+	switch {
+	case math.IsNaN(v):
+		return "nan"
+	case math.IsInf(v, 1):
+		return "inf"
+	case math.IsInf(v, -1):
+		return "-inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, bitSize)
+}
+
+// formatTimeParameter formats d as a Time or Time64 value, e.g. -100:05:00.250 for Time64(3).
+func formatTimeParameter(d time.Duration, name string, args []string) string {
+	scale := 0
+	if name == "Time64" {
+		scale = 3
+		if len(args) > 0 {
+			if s, err := strconv.Atoi(args[0]); err == nil && s >= 0 && s <= 9 {
+				scale = s
+			}
+		}
+	}
+
+	sign, abs := "", uint64(d)
+	if d < 0 {
+		sign, abs = "-", -abs
+	}
+	s := fmt.Sprintf("%s%02d:%02d:%02d", sign, abs/uint64(time.Hour), abs/uint64(time.Minute)%60, abs/uint64(time.Second)%60)
+	if scale > 0 {
+		s += fmt.Sprintf(".%09d", abs%uint64(time.Second))[:scale+1]
+	}
+	return s
+}
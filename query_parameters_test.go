@@ -0,0 +1,119 @@
+package clickhouse
+
+import (
+	"database/sql"
+	"net"
+	"testing"
+	"time"
+
+	chproto "github.com/ClickHouse/ch-go/proto"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/chcol"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryParameterTypes(t *testing.T) {
+	types := queryParameterTypes("SELECT {a:Array(String)}, { b : Map(String, UInt8) }, '{c:String}', {d:Enum8('x}' = 1, 'y' = 2)} FROM {t:Identifier}")
+	assert.Equal(t, map[string]string{
+		"a": "Array(String)",
+		"b": "Map(String, UInt8)",
+		"d": "Enum8('x}' = 1, 'y' = 2)",
+		"t": "Identifier",
+	}, types)
+}
+
+func TestFormatQueryParameter(t *testing.T) {
+	tz, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	str := "it's"
+	id := uuid.MustParse("b0e1c1a4-0c56-4b6f-9f54-52b0e8e6b8a1")
+
+	cases := []struct {
+		name     string
+		chType   string
+		value    any
+		expected string
+	}{
+		{"string is escaped", "String", "a\tb\\c'd", `a\tb\\c'd`},
+		{"pre-formatted string", "UInt64", "42", "42"},
+		{"array of strings", "Array(String)", []string{"a'b", `c\`, "], 'x"}, `['a\'b','c\\','], \'x']`},
+		{"array of nullable", "Array(Nullable(String))", []*string{&str, nil}, `['it\'s',NULL]`},
+		{"nested arrays", "Array(Array(UInt8))", [][]uint8{{1, 2}, {}}, "[[1,2],[]]"},
+		{"map", "Map(String, Array(Int32))", map[string][]int32{"b": {2}, "a": {-1}}, `{'a':[-1],'b':[2]}`},
+		{"tuple from slice", "Tuple(String, Float64)", []any{"x", 1.5}, `('x',1.5)`},
+		{"named tuple from map", "Tuple(a String, b UInt8)", map[string]any{"b": uint8(2), "a": "x"}, `('x',2)`},
+		{"named tuple from struct", "Tuple(a String, `b` Nullable(UInt8))", struct {
+			A string `ch:"a"`
+			B *uint8 `ch:"b"`
+		}{A: "x"}, `('x',NULL)`},
+		{"nullable nil", "Nullable(String)", nil, `\N`},
+		{"sql.Null", "Nullable(Int64)", sql.NullInt64{Int64: 7, Valid: true}, "7"},
+		{"sql.Null nil", "Nullable(Int64)", sql.NullInt64{}, `\N`},
+		{"low cardinality", "LowCardinality(String)", "a\nb", `a\nb`},
+		{"datetime in server timezone", "DateTime", ts, "2024-01-02 04:04:05"},
+		{"datetime64 with scale and timezone", "DateTime64(3, 'Asia/Tokyo')", ts, "2024-01-02 12:04:05.123"},
+		{"array of datetime64", "Array(DateTime64(6, 'UTC'))", []time.Time{ts}, `['2024-01-02 03:04:05.123456']`},
+		{"date", "Array(Date)", []time.Time{ts}, `['2024-01-02']`},
+		{"decimal", "Decimal(10, 2)", decimal.RequireFromString("12.34"), "12.34"},
+		{"array of decimals", "Array(Decimal(10, 2))", []decimal.Decimal{decimal.RequireFromString("-1.5")}, "[-1.5]"},
+		{"decimal64", "Decimal(10, 2)", chcol.Decimal64{Value: 1234, Scale: 2}, "12.34"},
+		{"negative decimal64 below one", "Decimal(10, 3)", chcol.Decimal64{Value: -5, Scale: 3}, "-0.005"},
+		{"decimal64 without scale", "Decimal(10, 0)", chcol.Decimal64{Value: 42}, "42"},
+		{"decimal128", "Decimal(38, 4)", chcol.Decimal128{Value: chproto.Int128{Low: 123456789}, Scale: 4}, "12345.6789"},
+		{"negative decimal128", "Array(Decimal(38, 2))", []chcol.Decimal128{{Value: chproto.Int128{Low: ^uint64(0) - 149, High: ^uint64(0)}, Scale: 2}}, "[-1.50]"},
+		{"uuid", "Array(UUID)", []uuid.UUID{id}, `['b0e1c1a4-0c56-4b6f-9f54-52b0e8e6b8a1']`},
+		{"ipv6", "Array(IPv6)", []net.IP{net.ParseIP("2001:db8::1")}, `['2001:db8::1']`},
+		{"enum name", "Enum8('a' = 1, 'b\\'c' = 2)", "b'c", "b'c"},
+		{"enum value", "Array(Enum8('a' = 1, 'b\\'c' = 2))", []int8{2, 1}, `['b\'c','a']`},
+		{"bool", "Array(Bool)", []bool{true, false}, "[true,false]"},
+		{"float", "Array(Float64)", []float64{0.1, 1e21}, "[0.1,1e+21]"},
+		{"time64", "Time64(3)", -(90*time.Minute + 250*time.Millisecond), "-01:30:00.250"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := FormatQueryParameter(tc.chType, tc.value, tz)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestFormatQueryParameterErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		chType string
+		value  any
+	}{
+		{"injection in array of numbers", "Array(UInt8)", []string{"1], ['2"}},
+		{"nil for non-nullable", "Array(String)", []*string{nil}},
+		{"tuple size", "Tuple(String, UInt8)", []any{"a"}},
+		{"unknown enum value", "Enum8('a' = 1)", 3},
+		{"not an array", "Array(String)", 1},
+		{"no serializer for the type", "Identifier", 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := FormatQueryParameter(tc.chType, tc.value, time.UTC)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestBindQueryParametersTyped(t *testing.T) {
+	var options QueryOptions
+	query := "SELECT {tags:Array(String)}, {name:String}, {other:UInt8}"
+	_, err := bindQueryOrAppendParameters(true, &options, query, time.UTC,
+		Named("tags", []string{"a'b", "c"}),
+		Named("name", `x\y`),
+		Named("other", uint8(1)),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, Parameters{
+		"tags":  `['a\'b','c']`,
+		"name":  `x\\y`,
+		"other": "1",
+	}, options.parameters)
+}
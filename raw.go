@@ -0,0 +1,134 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/column"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+)
+
+var rawFormatMatch = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// QueryRaw executes the query on conn and returns its result in format, e.g. CSVWithNames, JSONEachRow or Parquet,
+// as sent by the server. The result must be closed to release the connection. Parameters of the context
+// are sent as query parameters. Only the HTTP protocol supports raw query results, see ErrRawFormatUnsupported.
+//
+// An error raised by the server after the result started, e.g. in the middle of a long export,
+// is written into the result as text, like for any other HTTP client.
+//
+// conn must implement driver.RawQueryer, as the connections returned by Open do,
+// otherwise an error wrapping errors.ErrUnsupported is returned.
+func QueryRaw(ctx context.Context, conn driver.Conn, query, format string) (io.ReadCloser, error) {
+	raw, ok := conn.(driver.RawQueryer)
+	if !ok {
+		return nil, errUnsupportedConn(conn, "driver.RawQueryer")
+	}
+	return raw.QueryRaw(ctx, query, format)
+}
+
+// QueryRaw implements driver.RawQueryer, see the QueryRaw function.
+func (ch *clickhouse) QueryRaw(ctx context.Context, query, format string) (io.ReadCloser, error) {
+	if ch.opt.Protocol != HTTP {
+		return nil, ErrRawFormatUnsupported
+	}
+	if err := checkRawFormat(format); err != nil {
+		return nil, err
+	}
+	conn, err := ch.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	h, ok := conn.(*httpConnect)
+	if !ok {
+		ch.release(conn, nil)
+		return nil, ErrRawFormatUnsupported
+	}
+	h.getLogger().Debug("executing raw query", slog.String("sql", query), slog.String("format", format))
+	return h.queryRaw(ctx, ch.release, query, format)
+}
+
+// InsertRaw inserts data encoded in format, e.g. CSV or Parquet, into table using conn. The table may be qualified
+// with its database, as in db.events, and columns restricts the insert to the given columns. The table
+// and the columns are quoted as identifiers. Over HTTP data is streamed to the server. The native protocol
+// sends data inline after the INSERT query, which requires reading all of it into memory first.
+//
+// conn must implement driver.RawInserter, as the connections returned by Open do,
+// otherwise an error wrapping errors.ErrUnsupported is returned.
+func InsertRaw(ctx context.Context, conn driver.Conn, table, format string, data io.Reader, columns ...string) error {
+	raw, ok := conn.(driver.RawInserter)
+	if !ok {
+		return errUnsupportedConn(conn, "driver.RawInserter")
+	}
+	return raw.InsertRaw(ctx, table, format, data, columns...)
+}
+
+// InsertRaw implements driver.RawInserter, see the InsertRaw function.
+func (ch *clickhouse) InsertRaw(ctx context.Context, table, format string, data io.Reader, columns ...string) error {
+	if err := checkRawFormat(format); err != nil {
+		return err
+	}
+	query, err := rawInsertQuery(table, format, columns)
+	if err != nil {
+		return err
+	}
+	conn, err := ch.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	conn.getLogger().Debug("executing raw insert", slog.String("sql", query))
+
+	switch conn := conn.(type) {
+	case *httpConnect:
+		err = conn.insertRaw(ctx, query, data)
+	case *connect:
+		err = conn.insertRaw(ctx, query, data)
+	default:
+		err = ErrRawFormatUnsupported
+	}
+	ch.release(conn, err)
+	return err
+}
+
+func checkRawFormat(format string) error {
+	if !rawFormatMatch.MatchString(format) {
+		return fmt.Errorf("clickhouse: invalid format %q", format)
+	}
+	return nil
+}
+
+// rawInsertQuery returns the INSERT statement of InsertRaw, quoting the table and the columns.
+func rawInsertQuery(table, format string, columns []string) (string, error) {
+	var query strings.Builder
+	query.WriteString("INSERT INTO ")
+	db, name, qualified := strings.Cut(table, ".")
+	if !qualified {
+		db, name = "", table
+	}
+	if name == "" || qualified && db == "" {
+		return "", fmt.Errorf("clickhouse: invalid table name %q", table)
+	}
+	if qualified {
+		query.WriteString(column.QuoteIdentifier(db) + ".")
+	}
+	query.WriteString(column.QuoteIdentifier(name))
+	if len(columns) != 0 {
+		query.WriteString(" (")
+		for i, name := range columns {
+			if name == "" {
+				return "", fmt.Errorf("clickhouse: empty column name")
+			}
+			if i > 0 {
+				query.WriteString(", ")
+			}
+			query.WriteString(column.QuoteIdentifier(name))
+		}
+		query.WriteString(")")
+	}
+	query.WriteString(" FORMAT " + format)
+	return query.String(), nil
+}
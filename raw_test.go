@@ -0,0 +1,51 @@
+package clickhouse
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRawFormat(t *testing.T) {
+	for _, format := range []string{"CSV", "CSVWithNames", "JSONEachRow", "Parquet", "Arrow", "TabSeparatedRaw"} {
+		assert.NoError(t, checkRawFormat(format), format)
+	}
+	for _, format := range []string{"", "CSV; DROP TABLE t", "JSON Each", "1CSV"} {
+		assert.Error(t, checkRawFormat(format), format)
+	}
+}
+
+func TestRawInsertQuery(t *testing.T) {
+	tests := []struct {
+		table    string
+		columns  []string
+		expected string
+	}{
+		{"events", nil, "INSERT INTO events FORMAT CSV"},
+		{"db.events", []string{"id", "name"}, "INSERT INTO db.events (id, name) FORMAT CSV"},
+		{"events; DROP TABLE t", nil, "INSERT INTO `events; DROP TABLE t` FORMAT CSV"},
+		{"events", []string{"a b", "c`d"}, "INSERT INTO events (`a b`, `c\\`d`) FORMAT CSV"},
+	}
+	for _, tt := range tests {
+		query, err := rawInsertQuery(tt.table, "CSV", tt.columns)
+		require.NoError(t, err, tt.table)
+		assert.Equal(t, tt.expected, query)
+	}
+	for _, table := range []string{"", ".events", "db."} {
+		_, err := rawInsertQuery(table, "CSV", nil)
+		assert.Error(t, err, table)
+	}
+	_, err := rawInsertQuery("events", "CSV", []string{""})
+	assert.Error(t, err)
+}
+
+func TestRaw_UnsupportedConn(t *testing.T) {
+	_, err := QueryRaw(context.Background(), plainConn{}, "SELECT 1", "CSV")
+	assert.ErrorIs(t, err, errors.ErrUnsupported)
+	err = InsertRaw(context.Background(), plainConn{}, "t", "CSV", strings.NewReader("1\n"))
+	assert.ErrorIs(t, err, errors.ErrUnsupported)
+}
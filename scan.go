@@ -63,6 +63,12 @@ func (ch *clickhouse) Select(ctx context.Context, dest any, query string, args .
 
 func scan(block *proto.Block, row int, dest ...any) error {
 	columns := block.Columns
+	if len(columns) != len(dest) {
+		if grouped := block.GroupedColumns(); len(grouped) == len(dest) {
+			// a Nested column flattened into Array columns scanned into a slice of structs or maps
+			columns = grouped
+		}
+	}
 	if len(columns) != len(dest) {
 		return &OpError{
 			Op:  "Scan",
@@ -73,7 +79,7 @@ func scan(block *proto.Block, row int, dest ...any) error {
 		if err := columns[i].ScanRow(d, row-1); err != nil {
 			return &OpError{
 				Err:        err,
-				ColumnName: block.ColumnsNames()[i],
+				ColumnName: columns[i].Name(),
 			}
 		}
 	}
@@ -0,0 +1,234 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+)
+
+// ExecScript executes the statements of script one after another on a single connection of conn and returns
+// the result of every executed statement. Statements are separated by semicolons outside of string
+// literals, quoted identifiers, comments and heredocs. Statements consisting only of comments are skipped.
+// Inline data of INSERT ... FORMAT statements is not supported.
+//
+// By default, the execution stops at the first failed statement and its error is returned,
+// see driver.WithContinueOnError. Every statement gets its own query ID, derived from the query ID
+// of the context if any.
+//
+// conn must implement driver.ScriptExecer, as the connections returned by Open do,
+// otherwise an error wrapping errors.ErrUnsupported is returned.
+func ExecScript(ctx context.Context, conn driver.Conn, script string, opts ...driver.ScriptOption) ([]driver.StatementResult, error) {
+	scripts, ok := conn.(driver.ScriptExecer)
+	if !ok {
+		return nil, errUnsupportedConn(conn, "driver.ScriptExecer")
+	}
+	return scripts.ExecScript(ctx, script, opts...)
+}
+
+// ExecScript implements driver.ScriptExecer, see the ExecScript function.
+func (ch *clickhouse) ExecScript(ctx context.Context, script string, opts ...driver.ScriptOption) ([]driver.StatementResult, error) {
+	var options driver.ScriptOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	statements, err := splitScript(script)
+	if err != nil {
+		return nil, err
+	}
+	if len(statements) == 0 {
+		return nil, nil
+	}
+
+	conn, err := ch.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		results  = make([]driver.StatementResult, 0, len(statements))
+		parent   = queryOptions(ctx)
+		firstErr error
+		connErr  error
+	)
+	for i, statement := range statements {
+		var queryID string
+		if parent.queryID != "" {
+			queryID = fmt.Sprintf("%s-%d", parent.queryID, i+1)
+		}
+		conn.getLogger().Debug("executing script statement", slog.Int("line", statement.line), slog.String("sql", statement.text))
+		result := driver.StatementResult{
+			Statement: statement.text,
+			Line:      statement.line,
+		}
+		result.ExecResult, result.Err = execWithResult(Context(ctx, WithQueryID(queryID)), conn, statement.text)
+		results = append(results, result)
+
+		if result.Err == nil {
+			continue
+		}
+		if firstErr == nil {
+			firstErr = fmt.Errorf("clickhouse [ExecScript]: statement %d at line %d: %w", i+1, statement.line, result.Err)
+		}
+		if conn.isBad() || isConnBrokenError(result.Err) {
+			connErr = result.Err
+			break
+		}
+		if !options.ContinueOnError {
+			break
+		}
+	}
+
+	ch.release(conn, connErr)
+	return results, firstErr
+}
+
+// scriptStatement is a statement of a script and the line it starts at.
+type scriptStatement struct {
+	text string
+	line int
+}
+
+// splitScript splits script into statements at the semicolons outside of quotes, comments and heredocs.
+// Leading comments and whitespace are removed from every statement, statements that are empty
+// after that are dropped.
+func splitScript(script string) ([]scriptStatement, error) {
+	var (
+		statements []scriptStatement
+		start      = -1 // the first character of the current statement, -1 until one is found
+		line       = 1
+		startLine  int
+	)
+	flush := func(end int) {
+		if start != -1 {
+			statements = append(statements, scriptStatement{
+				text: strings.TrimSpace(script[start:end]),
+				line: startLine,
+			})
+		}
+		start = -1
+	}
+
+	for i := 0; i < len(script); {
+		c := script[i]
+		var (
+			end int // the index after the token
+			err error
+		)
+		switch {
+		case c == ';':
+			flush(i)
+			i++
+			continue
+		case c == '\n':
+			line++
+			i++
+			continue
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			i++
+			continue
+		case strings.HasPrefix(script[i:], "--") || strings.HasPrefix(script[i:], "#!") || strings.HasPrefix(script[i:], "# "):
+			end = strings.IndexByte(script[i:], '\n')
+			if end == -1 {
+				end = len(script)
+			} else {
+				end += i
+			}
+			// comments do not start a statement
+			i = end
+			continue
+		case strings.HasPrefix(script[i:], "/*"):
+			if end, err = skipBlockComment(script, i); err != nil {
+				return nil, fmt.Errorf("%w at line %d", err, line)
+			}
+			line += strings.Count(script[i:end], "\n")
+			i = end
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			if end, err = skipQuotedToken(script, i); err != nil {
+				return nil, fmt.Errorf("%w at line %d", err, line)
+			}
+		case c == '$':
+			var ok bool
+			if end, ok, err = skipHeredoc(script, i); err != nil {
+				return nil, fmt.Errorf("%w at line %d", err, line)
+			}
+			if !ok {
+				end = i + 1
+			}
+		default:
+			end = i + 1
+		}
+
+		if start == -1 {
+			start, startLine = i, line
+		}
+		line += strings.Count(script[i:end], "\n")
+		i = end
+	}
+	flush(len(script))
+	return statements, nil
+}
+
+// skipBlockComment returns the index after the end of the comment starting at start. Comments may be nested.
+func skipBlockComment(script string, start int) (int, error) {
+	depth := 0
+	for i := start; i < len(script)-1; i++ {
+		switch script[i : i+2] {
+		case "/*":
+			depth++
+			i++
+		case "*/":
+			depth--
+			i++
+			if depth == 0 {
+				return i + 1, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("clickhouse [ExecScript]: unterminated comment")
+}
+
+// skipQuotedToken returns the index after the string literal or quoted identifier starting at start.
+// Quotes are escaped with a backslash or by doubling them.
+func skipQuotedToken(script string, start int) (int, error) {
+	quote := script[start]
+	for i := start + 1; i < len(script); i++ {
+		switch script[i] {
+		case '\\':
+			i++
+		case quote:
+			if i+1 < len(script) && script[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("clickhouse [ExecScript]: unterminated %c", quote)
+}
+
+// skipHeredoc returns the index after the heredoc starting at start, e.g. $$text$$ or $tag$text$tag$.
+// ok is false if there is no heredoc at start, e.g. for a $1 placeholder.
+func skipHeredoc(script string, start int) (end int, ok bool, err error) {
+	i := start + 1
+	for i < len(script) && (isIdentifierByte(script[i]) && !(i == start+1 && script[i] >= '0' && script[i] <= '9')) {
+		i++
+	}
+	if i >= len(script) || script[i] != '$' {
+		return 0, false, nil
+	}
+	delimiter := script[start : i+1]
+	closing := strings.Index(script[i+1:], delimiter)
+	if closing == -1 {
+		return 0, false, fmt.Errorf("clickhouse [ExecScript]: unterminated heredoc %s", delimiter)
+	}
+	return i + 1 + closing + len(delimiter), true, nil
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
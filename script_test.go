@@ -0,0 +1,57 @@
+package clickhouse
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitScript(t *testing.T) {
+	script := `-- create the table; with a comment
+CREATE TABLE t (s String, "a;b" UInt8, ` + "`c;d`" + ` UInt8) ENGINE = Memory;
+
+/* block; /* nested; */ comment */
+INSERT INTO t VALUES ('it''s;', 1, 2), ('\';', 3, 4);;
+# hash comment;
+SELECT $$heredoc; with 'quotes$$, $tag$ $$; $tag$, $1 -- trailing;
+;
+CREATE FUNCTION f AS (x) -> concat(x, ';')
+`
+	statements, err := splitScript(script)
+	require.NoError(t, err)
+	assert.Equal(t, []scriptStatement{
+		{text: "CREATE TABLE t (s String, \"a;b\" UInt8, `c;d` UInt8) ENGINE = Memory", line: 2},
+		{text: `INSERT INTO t VALUES ('it''s;', 1, 2), ('\';', 3, 4)`, line: 5},
+		{text: "SELECT $$heredoc; with 'quotes$$, $tag$ $$; $tag$, $1 -- trailing;", line: 7},
+		{text: "CREATE FUNCTION f AS (x) -> concat(x, ';')", line: 9},
+	}, statements)
+
+	statements, err = splitScript("SELECT '\n;\n'; SELECT 2")
+	require.NoError(t, err)
+	assert.Equal(t, []scriptStatement{{text: "SELECT '\n;\n'", line: 1}, {text: "SELECT 2", line: 3}}, statements)
+
+	statements, err = splitScript(" -- only comments\n/* ; */ ; ")
+	require.NoError(t, err)
+	assert.Empty(t, statements)
+
+	for _, script := range []string{"SELECT 'a", "SELECT `a", "SELECT 1 /* /* */", "SELECT $x$ a"} {
+		_, err := splitScript(script)
+		assert.Error(t, err, script)
+	}
+}
+
+// plainConn is a driver.Conn implementing none of the optional interfaces.
+type plainConn struct {
+	driver.Conn
+}
+
+func TestExecScript_UnsupportedConn(t *testing.T) {
+	_, err := ExecScript(context.Background(), plainConn{}, "SELECT 1")
+	assert.ErrorIs(t, err, errors.ErrUnsupported)
+	assert.ErrorContains(t, err, "driver.ScriptExecer")
+}
@@ -3,6 +3,7 @@ package clickhouse
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 )
 
@@ -47,8 +48,22 @@ func (m *structMap) Map(op string, columns []string, s any, ptr bool) ([]any, er
 		index = structIdx(t)
 		m.cache.Store(t, index)
 	}
+	var nested string
 	for _, name := range columns {
 		idx, found := index[name]
+		if !found {
+			// a Nested column flattened into Array columns, e.g. n.a and n.b, maps to a single field n
+			if prefix, _, ok := strings.Cut(name, "."); ok && !mapsSubColumn(index, columns, prefix) {
+				if prefix == nested {
+					continue
+				}
+				if idx, found = index[prefix]; found {
+					nested = prefix
+				}
+			}
+		} else {
+			nested = ""
+		}
 		if !found {
 			return nil, &OpError{
 				Op:  op,
@@ -65,6 +80,19 @@ func (m *structMap) Map(op string, columns []string, s any, ptr bool) ([]any, er
 	return values, nil
 }
 
+// mapsSubColumn reports whether a field is tagged with the name of one of the columns starting with prefix,
+// e.g. n.a, in which case the flattened Nested column n is not mapped to a single field.
+func mapsSubColumn(index map[string][]int, columns []string, prefix string) bool {
+	for _, name := range columns {
+		if p, _, ok := strings.Cut(name, "."); ok && p == prefix {
+			if _, found := index[name]; found {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func structIdx(t reflect.Type) map[string][]int {
 	fields := make(map[string][]int)
 	for i := 0; i < t.NumField(); i++ {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStructIdx(t *testing.T) {
@@ -93,3 +94,41 @@ func BenchmarkStructMap(b *testing.B) {
 		}
 	}
 }
+
+func TestMapper_FlattenedNested(t *testing.T) {
+	type Item struct {
+		A string `ch:"a"`
+		B uint8  `ch:"b"`
+	}
+	type Grouped struct {
+		ID uint8  `ch:"id"`
+		N  []Item `ch:"n"`
+	}
+	type Explicit struct {
+		ID uint8    `ch:"id"`
+		N  []Item   `ch:"n"`
+		A  []string `ch:"n.a"`
+		B  []uint8  `ch:"n.b"`
+	}
+	type Partial struct {
+		N []Item   `ch:"n"`
+		A []string `ch:"n.a"`
+	}
+	var (
+		mapper  = structMap{}
+		columns = []string{"id", "n.a", "n.b"}
+	)
+
+	var grouped Grouped
+	values, err := mapper.Map("ScanStruct", columns, &grouped, true)
+	require.NoError(t, err)
+	assert.Equal(t, []any{&grouped.ID, &grouped.N}, values)
+
+	var explicit Explicit
+	values, err = mapper.Map("ScanStruct", columns, &explicit, true)
+	require.NoError(t, err)
+	assert.Equal(t, []any{&explicit.ID, &explicit.A, &explicit.B}, values)
+
+	_, err = mapper.Map("ScanStruct", columns[1:], &Partial{}, true)
+	assert.Error(t, err, "n.b must not map to n once n.a is tagged")
+}
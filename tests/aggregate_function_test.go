@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateFunction(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn, err := GetNativeConnection(t, protocol, nil, nil, &clickhouse.Compression{
+			Method: clickhouse.CompressionLZ4,
+		})
+		ctx := context.Background()
+		require.NoError(t, err)
+		const ddl = `
+		CREATE TABLE test_aggregate_function (
+			  Col1 UInt64
+			, Col2 AggregateFunction(count)
+			, Col3 AggregateFunction(sum, UInt32)
+			, Col4 AggregateFunction(max, String)
+			, Col5 AggregateFunction(avg, Int32)
+			, Col6 AggregateFunction(uniqExact, UInt64)
+			, Col7 AggregateFunction(uniq, String)
+			, Col8 AggregateFunction(groupArray, Int16)
+		) Engine AggregatingMergeTree() ORDER BY Col1
+		`
+		defer func() {
+			conn.Exec(ctx, "DROP TABLE IF EXISTS test_aggregate_function")
+			conn.Exec(ctx, "DROP TABLE IF EXISTS test_aggregate_function_copy")
+		}()
+		require.NoError(t, conn.Exec(ctx, ddl))
+		require.NoError(t, conn.Exec(ctx, `
+		INSERT INTO test_aggregate_function SELECT
+			  1
+			, countState()
+			, sumState(toUInt32(number))
+			, maxState(toString(number))
+			, avgState(toInt32(number))
+			, uniqExactState(number % 3)
+			, uniqState(toString(number))
+			, groupArrayState(toInt16(number))
+		FROM numbers(5)
+		`))
+
+		var (
+			count      uint64
+			sum        uint64
+			max        string
+			avg        float64
+			uniqExact  uint64
+			uniqState  []byte
+			groupArray []int16
+		)
+		require.NoError(t, conn.QueryRow(ctx, "SELECT Col2, Col3, Col4, Col5, Col6, Col7, Col8 FROM test_aggregate_function").
+			Scan(&count, &sum, &max, &avg, &uniqExact, &uniqState, &groupArray))
+		assert.Equal(t, uint64(5), count)
+		assert.Equal(t, uint64(10), sum)
+		assert.Equal(t, "4", max)
+		assert.Equal(t, float64(2), avg)
+		assert.Equal(t, uint64(3), uniqExact)
+		assert.NotEmpty(t, uniqState)
+		assert.Equal(t, []int16{0, 1, 2, 3, 4}, groupArray)
+
+		// round-trip the raw states into another table
+		require.NoError(t, conn.Exec(ctx, "CREATE TABLE test_aggregate_function_copy AS test_aggregate_function"))
+		rows, err := conn.Query(ctx, "SELECT * FROM test_aggregate_function")
+		require.NoError(t, err)
+		batch, err := conn.PrepareBatch(ctx, "INSERT INTO test_aggregate_function_copy")
+		require.NoError(t, err)
+		for rows.Next() {
+			var (
+				key    uint64
+				states = make([][]byte, 7)
+			)
+			require.NoError(t, rows.Scan(&key, &states[0], &states[1], &states[2], &states[3], &states[4], &states[5], &states[6]))
+			require.NoError(t, batch.Append(key, states[0], states[1], states[2], states[3], states[4], states[5], states[6]))
+		}
+		require.NoError(t, rows.Err())
+		require.NoError(t, batch.Send())
+
+		var (
+			uniq      uint64
+			copyCount uint64
+		)
+		require.NoError(t, conn.QueryRow(ctx, "SELECT countMerge(Col2), uniqMerge(Col7) FROM test_aggregate_function_copy").Scan(&copyCount, &uniq))
+		assert.Equal(t, uint64(5), copyCount)
+		assert.Equal(t, uint64(5), uniq)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
@@ -447,3 +448,41 @@ func TestDecimalStringValuer(t *testing.T) {
 		assert.Equal(t, 256.8, col5.val)
 	})
 }
+
+func TestDecimalBackends(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn, err := GetNativeConnection(t, protocol, nil, nil, &clickhouse.Compression{
+			Method: clickhouse.CompressionLZ4,
+		})
+		require.NoError(t, err)
+		ctx := context.Background()
+		const ddl = `
+			CREATE TABLE test_decimal_backends (
+				  Col1 Decimal64(4)
+				, Col2 Decimal128(10)
+				, Col3 Decimal256(20)
+			) Engine MergeTree() ORDER BY tuple()
+		`
+		defer func() {
+			conn.Exec(ctx, "DROP TABLE IF EXISTS test_decimal_backends")
+		}()
+		require.NoError(t, conn.Exec(ctx, ddl))
+		batch, err := conn.PrepareBatch(ctx, "INSERT INTO test_decimal_backends")
+		require.NoError(t, err)
+		require.NoError(t, batch.Append(
+			clickhouse.Decimal64{Value: -1234567, Scale: 4},
+			big.NewRat(1, 3),
+			big.NewFloat(1.25),
+		))
+		require.NoError(t, batch.Send())
+		var (
+			col1 clickhouse.Decimal64
+			col2 big.Rat
+			col3 big.Float
+		)
+		require.NoError(t, conn.QueryRow(ctx, "SELECT * FROM test_decimal_backends").Scan(&col1, &col2, &col3))
+		assert.Equal(t, clickhouse.Decimal64{Value: -1234567, Scale: 4}, col1)
+		assert.Equal(t, "3333333333/10000000000", col2.String())
+		assert.Equal(t, "1.25", col3.Text('f', -1))
+	})
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
@@ -421,3 +422,54 @@ func TestDynamic_BatchFlush(t *testing.T) {
 		require.NoError(t, rows.Err())
 	})
 }
+
+func TestDynamic_Introspection(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn := setupDynamicTest(t, protocol)
+		ctx := context.Background()
+
+		rows, err := conn.Query(ctx, "SELECT arrayJoin([1::Dynamic, 'text'::Dynamic, NULL::Dynamic, toDateTime64('2024-12-13 02:09:30.123', 3, 'UTC')::Dynamic]) AS c")
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var (
+			values []string
+			types  []string
+		)
+		for rows.Next() {
+			if types == nil {
+				columnType, ok := rows.ColumnTypes()[0].(driver.VariantColumnType)
+				require.True(t, ok)
+				types = columnType.VariantTypes()
+			}
+
+			var row clickhouse.Dynamic
+			require.NoError(t, rows.Scan(&row))
+			require.NoError(t, row.Switch(clickhouse.VariantCases{
+				"Int64": func(v clickhouse.Dynamic) error {
+					n, err := clickhouse.VariantAs[sql.NullInt64](v)
+					values = append(values, fmt.Sprintf("int:%d", n.Int64))
+					return err
+				},
+				"DateTime64": func(v clickhouse.Dynamic) error {
+					ts, err := clickhouse.VariantAs[time.Time](v)
+					values = append(values, "time:"+ts.UTC().Format(time.RFC3339Nano))
+					return err
+				},
+				"NULL": func(clickhouse.Dynamic) error {
+					values = append(values, "null")
+					return nil
+				},
+				"": func(v clickhouse.Dynamic) error {
+					var s string
+					err := clickhouse.ScanVariant(v, &s)
+					values = append(values, v.Type()+":"+s)
+					return err
+				},
+			}))
+		}
+		require.NoError(t, rows.Err())
+		require.Equal(t, []string{"DateTime64(3, 'UTC')", "Int64", "String"}, types)
+		require.Equal(t, []string{"int:1", "String:text", "null", "time:" + dynamicTestDate.Format(time.RFC3339Nano)}, values)
+	})
+}
@@ -371,3 +371,50 @@ func TestEnumValuer(t *testing.T) {
 		assert.Equal(t, col7Data, col7)
 	})
 }
+
+type enumTestStatus int8
+
+const (
+	enumTestStatusActive   enumTestStatus = 1
+	enumTestStatusInactive enumTestStatus = 2
+)
+
+func (enumTestStatus) EnumValues() map[string]int {
+	return map[string]int{"active": 1, "inactive": 2}
+}
+
+func TestEnumTypeBinding(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn, err := GetNativeConnection(t, protocol, nil, nil, &clickhouse.Compression{
+			Method: clickhouse.CompressionLZ4,
+		})
+		ctx := context.Background()
+		require.NoError(t, err)
+		const ddl = `
+			CREATE TABLE test_enum_type (
+				  Col1 Enum8('active' = 1, 'inactive' = 2, 'deleted' = 3)
+				, Col2 Enum16('active' = 1, 'inactive' = 20)
+			) Engine MergeTree() ORDER BY tuple()
+		`
+		defer func() {
+			conn.Exec(ctx, "DROP TABLE IF EXISTS test_enum_type")
+		}()
+		require.NoError(t, conn.Exec(ctx, ddl))
+		batch, err := conn.PrepareBatch(ctx, "INSERT INTO test_enum_type")
+		require.NoError(t, err)
+		require.NoError(t, batch.Append(enumTestStatusInactive, "active"))
+		// the Go type doesn't match the definition of Col2
+		err = batch.Append(enumTestStatusActive, enumTestStatusActive)
+		require.ErrorContains(t, err, `"inactive" is 20, expected 2`)
+		require.NoError(t, batch.Abort())
+
+		batch, err = conn.PrepareBatch(ctx, "INSERT INTO test_enum_type")
+		require.NoError(t, err)
+		require.NoError(t, batch.Append(enumTestStatusInactive, "active"))
+		require.NoError(t, batch.Send())
+
+		var status enumTestStatus
+		require.NoError(t, conn.QueryRow(ctx, "SELECT Col1 FROM test_enum_type").Scan(&status))
+		assert.Equal(t, enumTestStatusInactive, status)
+	})
+}
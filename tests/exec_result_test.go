@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecWithResult(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn, err := GetNativeConnection(t, protocol, nil, nil, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+		ctx := context.Background()
+
+		require.NoError(t, conn.Exec(ctx, "CREATE TABLE test_exec_result (n UInt64) ENGINE = MergeTree ORDER BY n"))
+		defer func() {
+			conn.Exec(ctx, "DROP TABLE IF EXISTS test_exec_result")
+		}()
+
+		result, err := clickhouse.ExecWithResult(ctx, conn, "INSERT INTO test_exec_result SELECT number FROM numbers(100)")
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.QueryID)
+		assert.Equal(t, uint64(100), result.Summary.WrittenRows)
+		assert.Equal(t, uint64(800), result.Summary.WrittenBytes)
+		assert.Positive(t, result.Summary.Elapsed)
+
+		result, err = clickhouse.ExecWithResult(clickhouse.Context(ctx, clickhouse.WithQueryID("test-exec-result")), conn, "INSERT INTO test_exec_result VALUES (?)", 1)
+		require.NoError(t, err)
+		assert.Equal(t, "test-exec-result", result.QueryID)
+		assert.Equal(t, uint64(1), result.Summary.WrittenRows)
+	})
+}
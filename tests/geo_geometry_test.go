@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/encoding/wkb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoGeometry(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn, err := GetNativeConnection(t, protocol, nil, nil, &clickhouse.Compression{
+			Method: clickhouse.CompressionLZ4,
+		})
+		ctx := context.Background()
+		require.NoError(t, err)
+		if !CheckMinServerServerVersion(conn, 25, 11, 0) {
+			t.Skip(fmt.Errorf("unsupported clickhouse version"))
+			return
+		}
+		const ddl = `
+		CREATE TABLE test_geo_geometry (
+			  ID   UInt8
+			, Col1 Geometry
+			, Col2 Polygon
+		) Engine MergeTree() ORDER BY ID
+		`
+		defer func() {
+			conn.Exec(ctx, "DROP TABLE IF EXISTS test_geo_geometry")
+		}()
+		require.NoError(t, conn.Exec(ctx, ddl))
+		batch, err := conn.PrepareBatch(ctx, "INSERT INTO test_geo_geometry")
+		require.NoError(t, err)
+		polygon := orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}
+		polygonWKB, err := wkb.Marshal(polygon)
+		require.NoError(t, err)
+		require.NoError(t, batch.Append(uint8(1), orb.Point{1, 2}, polygonWKB))
+		require.NoError(t, batch.Append(uint8(2), polygon, "POLYGON((0 0,1 0,1 1,0 0))"))
+		require.NoError(t, batch.Append(uint8(3), "LINESTRING(1 2,3 4)", polygon))
+		require.NoError(t, batch.Send())
+
+		rows, err := conn.Query(ctx, "SELECT Col1, Col2 FROM test_geo_geometry ORDER BY ID")
+		require.NoError(t, err)
+		expected := []orb.Geometry{orb.Point{1, 2}, polygon, orb.LineString{{1, 2}, {3, 4}}}
+		var i int
+		for rows.Next() {
+			var (
+				col1 orb.Geometry
+				col2 string
+			)
+			require.NoError(t, rows.Scan(&col1, &col2))
+			assert.Equal(t, expected[i], col1)
+			assert.Equal(t, "POLYGON((0 0,1 0,1 1,0 0))", col2)
+			i++
+		}
+		require.NoError(t, rows.Err())
+		assert.Equal(t, 3, i)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/stretchr/testify/assert"
@@ -42,3 +43,41 @@ func TestInterval(t *testing.T) {
 		assert.Equal(t, "5 Minutes", col4)
 	})
 }
+
+func TestIntervalScanTypes(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn, err := GetNativeConnection(t, protocol, nil, nil, &clickhouse.Compression{
+			Method: clickhouse.CompressionLZ4,
+		})
+		ctx := context.Background()
+		require.NoError(t, err)
+		const query = `
+		SELECT
+			  toIntervalMillisecond(1500)
+			, toIntervalMonth(3)
+			, toIntervalHour(-2)
+			, toDateTime('2024-01-01 00:00:00', 'UTC') + $1
+		`
+		var (
+			col1 time.Duration
+			col2 clickhouse.Interval
+			col3 int64
+			col4 time.Time
+		)
+		err = conn.QueryRow(ctx, query, clickhouse.Interval{Value: 5, Unit: clickhouse.IntervalMinute}).Scan(
+			&col1,
+			&col2,
+			&col3,
+			&col4,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, 1500*time.Millisecond, col1)
+		assert.Equal(t, clickhouse.Interval{Value: 3, Unit: clickhouse.IntervalMonth}, col2)
+		assert.Equal(t, int64(-2), col3)
+		assert.Equal(t, time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC), col4.UTC())
+
+		var duration time.Duration
+		err = conn.QueryRow(ctx, "SELECT toIntervalDay(1)").Scan(&duration)
+		assert.Error(t, err)
+	})
+}
@@ -559,3 +559,77 @@ func TestJSONNullableStringsScan(t *testing.T) {
 		require.NoError(t, rows.Err())
 	})
 }
+
+func TestJSONSchemaHints(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn := setupJSONTest(t, protocol)
+		ctx := context.Background()
+
+		type Event struct {
+			ID      uint32 `json:"event.id" chType:"UInt32"`
+			Host    string `json:"source.host" chType:"String"`
+			Debug   string `json:"debug" chType:"SKIP"`
+			Payload string `json:"payload"`
+		}
+
+		chType, err := clickhouse.JSONTypeOf(Event{})
+		require.NoError(t, err)
+		require.Equal(t, "JSON(event.id UInt32, source.host String, SKIP debug)", string(chType))
+
+		require.NoError(t, conn.Exec(ctx, "CREATE TABLE IF NOT EXISTS test_json_schema_hints (c "+string(chType)+") Engine = MergeTree() ORDER BY tuple()"))
+		defer func() {
+			require.NoError(t, conn.Exec(ctx, "DROP TABLE IF EXISTS test_json_schema_hints"))
+		}()
+
+		batch, err := conn.PrepareBatch(ctx, "INSERT INTO test_json_schema_hints (c)")
+		require.NoError(t, err)
+		require.NoError(t, batch.Append(&Event{ID: 1, Host: "localhost", Debug: "skipped", Payload: "data"}))
+		require.NoError(t, batch.Append(map[string]any{"event": map[string]any{"id": uint32(2)}, "extra": "unexpected"}))
+		require.NoError(t, batch.Send())
+
+		rows, err := conn.Query(ctx, "SELECT c FROM test_json_schema_hints ORDER BY c.event.id")
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var event Event
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(clickhouse.StrictJSON(&event)))
+		require.Equal(t, Event{ID: 1, Host: "localhost", Payload: "data"}, event)
+
+		require.True(t, rows.Next())
+		require.ErrorContains(t, rows.Scan(clickhouse.StrictJSON(&event)), "extra")
+		require.NoError(t, rows.Err())
+	})
+}
+
+func TestJSONRawMessage(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn := setupJSONTest(t, protocol)
+		ctx := context.Background()
+
+		require.NoError(t, conn.Exec(ctx, "CREATE TABLE IF NOT EXISTS test_json_raw_message (id UInt8, c JSON(a.b Int64)) Engine = MergeTree() ORDER BY id"))
+		defer func() {
+			require.NoError(t, conn.Exec(ctx, "DROP TABLE IF EXISTS test_json_raw_message"))
+		}()
+
+		batch, err := conn.PrepareBatch(ctx, "INSERT INTO test_json_raw_message (id, c)")
+		require.NoError(t, err)
+		require.NoError(t, batch.Append(uint8(1), clickhouse.NewJSON()))
+		require.NoError(t, batch.Append(uint8(2), json.RawMessage(`{"z":"last","a":{"b":5,"c":[1,2]}}`)))
+		require.NoError(t, batch.Send())
+
+		rows, err := conn.Query(ctx, "SELECT c FROM test_json_raw_message ORDER BY id")
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var raw json.RawMessage
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(&raw))
+		require.JSONEq(t, `{"a":{"b":0}}`, string(raw))
+
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(&raw))
+		require.Equal(t, `{"a":{"b":5,"c":[1,2]},"z":"last"}`, string(raw))
+		require.NoError(t, rows.Err())
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -199,3 +200,49 @@ func TestLowCardinalityFlush(t *testing.T) {
 		require.Equal(t, 100, i)
 	})
 }
+
+func TestLowCardinalityDictionary(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn, err := GetNativeConnection(t, protocol, nil, nil, &clickhouse.Compression{
+			Method: clickhouse.CompressionLZ4,
+		})
+		require.NoError(t, err)
+		ctx := context.Background()
+		const ddl = `
+			CREATE TABLE test_lowcardinality_dictionary (
+				  ID    UInt64
+				, Level LowCardinality(String)
+			) Engine MergeTree() ORDER BY ID
+		`
+		defer func() {
+			conn.Exec(ctx, "DROP TABLE IF EXISTS test_lowcardinality_dictionary")
+		}()
+		require.NoError(t, conn.Exec(ctx, ddl))
+		levels := []string{"debug", "info", "warn", "error"}
+		batch, err := conn.PrepareBatch(ctx, "INSERT INTO test_lowcardinality_dictionary",
+			driver.WithLowCardinalityDictionary("Level", levels),
+		)
+		require.NoError(t, err)
+		for i := 0; i < 1000; i++ {
+			require.NoError(t, batch.Append(uint64(i), levels[i%len(levels)]))
+			if i%100 == 99 {
+				require.NoError(t, batch.Flush())
+			}
+		}
+		require.NoError(t, batch.Send())
+
+		rows, err := conn.Query(ctx, "SELECT Level FROM test_lowcardinality_dictionary ORDER BY ID")
+		require.NoError(t, err)
+		counts := make(map[clickhouse.LowCardinalityKey]int)
+		var i int
+		for rows.Next() {
+			var key clickhouse.LowCardinalityKey
+			require.NoError(t, rows.Scan(&key))
+			counts[key]++
+			i++
+		}
+		require.NoError(t, rows.Err())
+		assert.Equal(t, 1000, i)
+		assert.NotEmpty(t, counts)
+	})
+}
@@ -323,3 +323,65 @@ func TestNestedFlush(t *testing.T) {
 		require.Equal(t, 1000, i)
 	})
 }
+
+func TestNestedSliceOfStructs(t *testing.T) {
+	type Tag struct {
+		Key   string `ch:"key"`
+		Value uint32 `ch:"value"`
+	}
+	type Event struct {
+		ID   uint64 `ch:"id"`
+		Tags []Tag  `ch:"tags"`
+	}
+
+	for _, flatten := range []int{0, 1} {
+		t.Run(fmt.Sprintf("flatten_nested=%d", flatten), func(t *testing.T) {
+			TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+				conn, err := GetNativeConnection(t, protocol, clickhouse.Settings{
+					"flatten_nested": flatten,
+				}, nil, &clickhouse.Compression{
+					Method: clickhouse.CompressionLZ4,
+				})
+				ctx := context.Background()
+				require.NoError(t, err)
+				if !CheckMinServerServerVersion(conn, 22, 1, 0) {
+					t.Skip(fmt.Errorf("unsupported clickhouse version"))
+					return
+				}
+				const ddl = `
+					CREATE TABLE test_nested_structs (
+						  id UInt64
+						, tags Nested(
+							  key String
+							, value UInt32
+						)
+					) Engine MergeTree() ORDER BY id
+				`
+				defer func() {
+					conn.Exec(ctx, "DROP TABLE IF EXISTS test_nested_structs")
+				}()
+				require.NoError(t, conn.Exec(ctx, ddl))
+
+				events := []Event{
+					{ID: 1, Tags: []Tag{{Key: "a", Value: 1}, {Key: "b", Value: 2}}},
+					{ID: 2, Tags: []Tag{{Key: "c", Value: 3}}},
+					{ID: 3, Tags: []Tag{}},
+				}
+				batch, err := conn.PrepareBatch(ctx, "INSERT INTO test_nested_structs")
+				require.NoError(t, err)
+				require.NoError(t, batch.Append(events[0].ID, events[0].Tags))
+				require.NoError(t, batch.Append(events[1].ID, []map[string]any{{"key": "c", "value": uint32(3)}}))
+				require.NoError(t, batch.AppendStruct(&events[2]))
+				require.NoError(t, batch.Send())
+
+				var scanned []Event
+				require.NoError(t, conn.Select(ctx, &scanned, "SELECT * FROM test_nested_structs ORDER BY id"))
+				assert.Equal(t, events, scanned)
+
+				var tags []*Tag
+				require.NoError(t, conn.QueryRow(ctx, "SELECT tags.key, tags.value FROM test_nested_structs WHERE id = 1").Scan(&tags))
+				assert.Equal(t, []*Tag{{Key: "a", Value: 1}, {Key: "b", Value: 2}}, tags)
+			})
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/ClickHouse/clickhouse-go/v2/qb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryBuilder(t *testing.T) {
+	ctx := context.Background()
+
+	env, err := GetTestEnvironment(testSet)
+	require.NoError(t, err)
+	conn, err := TestClientWithDefaultSettings(env)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	if !CheckMinServerServerVersion(conn, 22, 8, 0) {
+		t.Skip(fmt.Errorf("unsupported clickhouse version"))
+		return
+	}
+
+	const ddl = `
+		CREATE TABLE test_qb (
+			  id       UInt64
+			, name     String
+			, tags     Array(String)
+			, ts       DateTime64(3)
+		) Engine ReplacingMergeTree ORDER BY id
+	`
+	require.NoError(t, conn.Exec(ctx, ddl))
+	defer func() {
+		conn.Exec(ctx, "DROP TABLE IF EXISTS test_qb")
+	}()
+
+	query, _, err := qb.Insert("test_qb").Columns("id", "name", "tags", "ts").Build()
+	require.NoError(t, err)
+	batch, err := conn.PrepareBatch(ctx, query)
+	require.NoError(t, err)
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, batch.Append(uint64(i), fmt.Sprintf("name '%d'", i), []string{"a", "b"}, start.Add(time.Duration(i)*time.Hour)))
+	}
+	require.NoError(t, batch.Send())
+
+	query, params, err := qb.Alter("test_qb").
+		Update("name", "it's\tupdated").
+		Where(qb.Eq("id", uint64(1))).
+		Settings("mutations_sync", 2).
+		Build()
+	require.NoError(t, err)
+	require.NoError(t, conn.Exec(clickhouse.Context(ctx, clickhouse.WithParameters(params)), query))
+
+	query, params, err = qb.Select("id", "name", "tags").
+		From("test_qb").
+		Final().
+		ArrayJoin("tags").
+		Prewhere(qb.In("id", []uint64{1, 2, 3})).
+		Where(qb.Gte("ts", start.Add(2*time.Hour)), qb.Eq("tags", "a")).
+		OrderBy(qb.Asc("id")).
+		LimitBy(1, "id").
+		Settings("max_threads", 1).
+		Build()
+	require.NoError(t, err)
+	rows, err := conn.Query(clickhouse.Context(ctx, clickhouse.WithParameters(params)), query)
+	require.NoError(t, err)
+	var ids []uint64
+	for rows.Next() {
+		var (
+			id   uint64
+			name string
+			tag  string
+		)
+		require.NoError(t, rows.Scan(&id, &name, &tag))
+		ids = append(ids, id)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []uint64{2, 3}, ids)
+
+	query, params, err = qb.Select("name").From("test_qb").Where(qb.Eq("id", uint64(1))).Build()
+	require.NoError(t, err)
+	var name string
+	require.NoError(t, conn.QueryRow(clickhouse.Context(ctx, clickhouse.WithParameters(params)), query).Scan(&name))
+	assert.Equal(t, "it's\tupdated", name)
+}
@@ -3,7 +3,11 @@ package tests
 import (
 	"context"
 	"fmt"
+	"net"
+
 	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -119,6 +123,54 @@ func TestQueryParameters(t *testing.T) {
 		require.NoError(t, row.Err())
 	})
 
+	t.Run("typed values", func(t *testing.T) {
+		tricky := []string{"it's", `back\slash`, "tab\there", "'], ['x"}
+		ts := time.Date(2024, 1, 2, 3, 4, 5, 123000000, time.UTC)
+		id := uuid.New()
+		var (
+			actualStrings []string
+			actualString  string
+			actualMap     map[string][]int32
+			actualTuple   []any
+			actualNull    *string
+			actualTime    time.Time
+			actualDecimal decimal.Decimal
+			actualUUID    uuid.UUID
+			actualIPs     []net.IP
+			actualEnum    string
+		)
+		row := client.QueryRow(
+			ctx,
+			`SELECT {strings:Array(String)}, {string:String}, {map:Map(String, Array(Int32))}, {tuple:Tuple(String, UInt8)},
+				{null:Nullable(String)}, {time:DateTime64(3, 'Asia/Tokyo')}, {decimal:Decimal(10, 2)}, {uuid:UUID},
+				{ips:Array(IPv6)}, {enum:Enum8('a' = 1, 'b''c' = 2)}`,
+			clickhouse.Named("strings", tricky),
+			clickhouse.Named("string", tricky[1]),
+			clickhouse.Named("map", map[string][]int32{"a'": {1, -2}}),
+			clickhouse.Named("tuple", []any{"x", uint8(1)}),
+			clickhouse.Named("null", nil),
+			clickhouse.Named("time", ts),
+			clickhouse.Named("decimal", decimal.RequireFromString("12.34")),
+			clickhouse.Named("uuid", id),
+			clickhouse.Named("ips", []net.IP{net.ParseIP("2001:db8::1")}),
+			clickhouse.Named("enum", 2),
+		)
+		require.NoError(t, row.Err())
+		require.NoError(t, row.Scan(&actualStrings, &actualString, &actualMap, &actualTuple, &actualNull,
+			&actualTime, &actualDecimal, &actualUUID, &actualIPs, &actualEnum))
+
+		assert.Equal(t, tricky, actualStrings)
+		assert.Equal(t, tricky[1], actualString)
+		assert.Equal(t, map[string][]int32{"a'": {1, -2}}, actualMap)
+		assert.Equal(t, []any{"x", uint8(1)}, actualTuple)
+		assert.Nil(t, actualNull)
+		assert.True(t, ts.Equal(actualTime))
+		assert.True(t, decimal.RequireFromString("12.34").Equal(actualDecimal))
+		assert.Equal(t, id, actualUUID)
+		assert.Equal(t, "2001:db8::1", actualIPs[0].String())
+		assert.Equal(t, "b'c", actualEnum)
+	})
+
 	t.Run("with bind backwards compatibility", func(t *testing.T) {
 		var actualNum uint8
 		var actualStr string
@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawFormats(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn, err := GetNativeConnection(t, protocol, nil, nil, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+		ctx := context.Background()
+
+		require.NoError(t, conn.Exec(ctx, "CREATE TABLE test_raw_formats (id UInt64, name String) ENGINE = MergeTree ORDER BY id"))
+		defer func() {
+			conn.Exec(ctx, "DROP TABLE IF EXISTS test_raw_formats")
+		}()
+
+		require.NoError(t, clickhouse.InsertRaw(ctx, conn, "test_raw_formats", "CSV", strings.NewReader("1,\"a,b\"\n2,c\n"), "id", "name"))
+		require.NoError(t, clickhouse.InsertRaw(ctx, conn, "test_raw_formats", "JSONEachRow", strings.NewReader(`{"id":3,"name":"d"}`)))
+		if protocol == clickhouse.Native {
+			var count uint64
+			require.NoError(t, conn.QueryRow(ctx, "SELECT count() FROM test_raw_formats WHERE name IN ('a,b', 'c', 'd')").Scan(&count))
+			assert.Equal(t, uint64(3), count)
+			_, err = clickhouse.QueryRaw(ctx, conn, "SELECT 1", "CSV")
+			require.ErrorIs(t, err, clickhouse.ErrRawFormatUnsupported)
+			return
+		}
+
+		result, err := clickhouse.QueryRaw(ctx, conn, "SELECT id, name FROM test_raw_formats ORDER BY id", "CSVWithNames")
+		require.NoError(t, err)
+		data, err := io.ReadAll(result)
+		require.NoError(t, err)
+		require.NoError(t, result.Close())
+		assert.Equal(t, "\"id\",\"name\"\n1,\"a,b\"\n2,\"c\"\n3,\"d\"\n", string(data))
+
+		result, err = clickhouse.QueryRaw(ctx, conn, "SELECT * FROM test_raw_formats", "Parquet")
+		require.NoError(t, err)
+		data, err = io.ReadAll(result)
+		require.NoError(t, err)
+		require.NoError(t, result.Close())
+		assert.Equal(t, "PAR1", string(data[:4]))
+
+		_, err = clickhouse.QueryRaw(ctx, conn, "SELECT 1", "CSV FORMAT")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPResultMetadata(t *testing.T) {
+	conn, err := GetNativeConnection(t, clickhouse.HTTP, nil, nil, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var (
+		metadata *clickhouse.ResultMetadata
+		progress []*clickhouse.Progress
+		info     *clickhouse.ProfileInfo
+	)
+	ctx := clickhouse.Context(context.Background(),
+		clickhouse.WithQueryID("test-http-result-metadata"),
+		clickhouse.WithSettings(clickhouse.Settings{"wait_end_of_query": 1}),
+		clickhouse.WithResultMetadata(func(m *clickhouse.ResultMetadata) {
+			metadata = m
+		}),
+		clickhouse.WithProgress(func(p *clickhouse.Progress) {
+			progress = append(progress, p)
+		}),
+		clickhouse.WithProfileInfo(func(p *clickhouse.ProfileInfo) {
+			info = p
+		}),
+	)
+	rows, err := conn.Query(ctx, "SELECT number FROM numbers(10)")
+	require.NoError(t, err)
+	var count int
+	for rows.Next() {
+		count++
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	assert.Equal(t, 10, count)
+
+	require.NotNil(t, metadata)
+	assert.Equal(t, "test-http-result-metadata", metadata.QueryID)
+	assert.Equal(t, "Native", metadata.Format)
+	assert.NotNil(t, metadata.Timezone)
+	assert.NotEmpty(t, metadata.ServerDisplayName)
+	require.NotNil(t, metadata.Summary)
+	assert.Equal(t, uint64(10), metadata.Summary.Rows)
+
+	require.Len(t, progress, 1)
+	assert.Equal(t, uint64(10), progress[0].Rows)
+	require.NotNil(t, info)
+	assert.Equal(t, uint64(10), info.Rows)
+}
+
+func TestHTTPProgressHeaders(t *testing.T) {
+	conn, err := GetNativeConnection(t, clickhouse.HTTP, nil, nil, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var (
+		total    clickhouse.Progress
+		metadata *clickhouse.ResultMetadata
+	)
+	ctx := clickhouse.Context(context.Background(),
+		clickhouse.WithSettings(clickhouse.Settings{
+			"send_progress_in_http_headers":     1,
+			"http_headers_progress_interval_ms": 1,
+			"wait_end_of_query":                 1,
+			"max_block_size":                    1000,
+		}),
+		clickhouse.WithProgress(func(p *clickhouse.Progress) {
+			total.Rows += p.Rows
+			total.Bytes += p.Bytes
+		}),
+		clickhouse.WithResultMetadata(func(m *clickhouse.ResultMetadata) {
+			metadata = m
+		}),
+	)
+	var count uint64
+	require.NoError(t, conn.QueryRow(ctx, "SELECT count() FROM numbers(100000) WHERE NOT ignore(sleepEachRow(0.000001))").Scan(&count))
+	assert.Equal(t, uint64(100000), count)
+
+	require.NotNil(t, metadata)
+	require.NotNil(t, metadata.Summary)
+	assert.Equal(t, metadata.Summary.Rows, total.Rows)
+	assert.Equal(t, metadata.Summary.Bytes, total.Bytes)
+}
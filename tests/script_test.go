@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecScript(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn, err := GetNativeConnection(t, protocol, nil, nil, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+		ctx := context.Background()
+
+		const script = `
+			-- creates the table; and fills it
+			CREATE TABLE test_exec_script (s String, n UInt64) ENGINE = MergeTree ORDER BY n;
+
+			/* rows with separators */
+			INSERT INTO test_exec_script VALUES ('a;b', 1), ('it''s;', 2);
+			INSERT INTO test_exec_script SELECT toString(number), number + 10 FROM numbers(5);
+		`
+		defer func() {
+			conn.Exec(ctx, "DROP TABLE IF EXISTS test_exec_script")
+		}()
+		results, err := clickhouse.ExecScript(ctx, conn, script)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.Equal(t, 3, results[0].Line)
+		assert.Equal(t, "INSERT INTO test_exec_script VALUES ('a;b', 1), ('it''s;', 2)", results[1].Statement)
+		for _, result := range results {
+			assert.NoError(t, result.Err)
+			assert.NotEmpty(t, result.QueryID)
+		}
+		if protocol == clickhouse.Native {
+			assert.Equal(t, uint64(5), results[2].Summary.WrittenRows)
+		}
+
+		var count uint64
+		require.NoError(t, conn.QueryRow(ctx, "SELECT count() FROM test_exec_script").Scan(&count))
+		assert.Equal(t, uint64(7), count)
+
+		const failing = `
+			SELECT throwIf(1, 'failed');
+			INSERT INTO test_exec_script VALUES ('c', 3);
+		`
+		results, err = clickhouse.ExecScript(ctx, conn, failing)
+		require.Error(t, err)
+		require.Len(t, results, 1)
+		assert.Error(t, results[0].Err)
+
+		results, err = clickhouse.ExecScript(ctx, conn, failing, driver.WithContinueOnError())
+		require.Error(t, err)
+		require.Len(t, results, 2)
+		assert.Error(t, results[0].Err)
+		assert.NoError(t, results[1].Err)
+	})
+}
@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sparseColumnsHandler records the sparse columns of the data blocks received by the connection.
+type sparseColumnsHandler struct {
+	mu      sync.Mutex
+	columns map[string]bool
+}
+
+func (h *sparseColumnsHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *sparseColumnsHandler) Handle(_ context.Context, record slog.Record) error {
+	record.Attrs(func(attr slog.Attr) bool {
+		if columns, ok := attr.Value.Any().([]string); ok && attr.Key == "sparse_columns" {
+			h.mu.Lock()
+			for _, name := range columns {
+				h.columns[name] = true
+			}
+			h.mu.Unlock()
+		}
+		return true
+	})
+	return nil
+}
+
+func (h *sparseColumnsHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *sparseColumnsHandler) WithGroup(string) slog.Handler      { return h }
+
+func TestSparseSerialization(t *testing.T) {
+	env, err := GetTestEnvironment(testSet)
+	require.NoError(t, err)
+	options := ClientOptionsFromEnv(env, clickhouse.Settings{}, false)
+	handler := &sparseColumnsHandler{columns: map[string]bool{}}
+	options.Logger = slog.New(handler)
+	conn, err := GetConnectionWithOptions(&options)
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx := context.Background()
+	const ddl = `
+		CREATE TABLE test_sparse_serialization (
+			  ID   UInt64
+			, Col1 UInt64
+			, Col2 String
+			, Col3 Nullable(Int32)
+		) Engine MergeTree() ORDER BY ID
+		SETTINGS ratio_of_defaults_for_sparse_serialization = 0.5
+	`
+	defer func() {
+		conn.Exec(ctx, "DROP TABLE IF EXISTS test_sparse_serialization")
+	}()
+	require.NoError(t, conn.Exec(ctx, ddl))
+
+	batch, err := conn.PrepareBatch(ctx, "INSERT INTO test_sparse_serialization", driver.WithSparseSerialization(0.5))
+	require.NoError(t, err)
+	const rows = 1000
+	for i := 0; i < rows; i++ {
+		var (
+			col1 uint64
+			col2 string
+			col3 *int32
+		)
+		if i%100 == 0 {
+			col1, col2, col3 = uint64(i), "value", new(int32)
+		}
+		require.NoError(t, batch.Append(uint64(i), col1, col2, col3))
+	}
+	require.NoError(t, batch.Send())
+
+	result, err := conn.Query(ctx, "SELECT * FROM test_sparse_serialization ORDER BY ID")
+	require.NoError(t, err)
+	var i int
+	for result.Next() {
+		var (
+			id   uint64
+			col1 uint64
+			col2 string
+			col3 *int32
+		)
+		require.NoError(t, result.Scan(&id, &col1, &col2, &col3))
+		require.Equal(t, uint64(i), id)
+		if i%100 == 0 {
+			assert.Equal(t, uint64(i), col1)
+			assert.Equal(t, "value", col2)
+			assert.NotNil(t, col3)
+		} else {
+			assert.Equal(t, uint64(0), col1)
+			assert.Equal(t, "", col2)
+			assert.Nil(t, col3)
+		}
+		i++
+	}
+	require.NoError(t, result.Err())
+	assert.Equal(t, rows, i)
+
+	// without sorting, the columns are read as stored in the part
+	plain, err := conn.Query(ctx, "SELECT Col1, Col2 FROM test_sparse_serialization SETTINGS max_threads = 1")
+	require.NoError(t, err)
+	var nonDefault int
+	for plain.Next() {
+		var (
+			col1 uint64
+			col2 string
+		)
+		require.NoError(t, plain.Scan(&col1, &col2))
+		if col2 != "" {
+			nonDefault++
+		}
+	}
+	require.NoError(t, plain.Err())
+	assert.Equal(t, rows/100, nonDefault)
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	assert.True(t, handler.columns["Col1"], "Col1 not received as sparse")
+	assert.True(t, handler.columns["Col2"], "Col2 not received as sparse")
+}
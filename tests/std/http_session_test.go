@@ -0,0 +1,51 @@
+package std
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	clickhouse_tests "github.com/ClickHouse/clickhouse-go/v2/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStdHTTPSessions(t *testing.T) {
+	useSSL, err := strconv.ParseBool(clickhouse_tests.GetEnv("CLICKHOUSE_USE_SSL", "false"))
+	require.NoError(t, err)
+	db, err := GetStdDSNConnection(clickhouse.HTTP, useSSL, url.Values{
+		"http_sessions":        []string{"true"},
+		"http_session_timeout": []string{"2m"},
+		"http_session_check":   []string{"true"},
+	})
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, "CREATE TEMPORARY TABLE std_test_http_session (n UInt64)")
+	require.NoError(t, err)
+	_, err = conn.ExecContext(ctx, "INSERT INTO std_test_http_session SELECT number FROM numbers(5)")
+	require.NoError(t, err)
+	_, err = conn.ExecContext(ctx, "SET max_block_size = 1234")
+	require.NoError(t, err)
+
+	var count uint64
+	require.NoError(t, conn.QueryRowContext(ctx, "SELECT count() FROM std_test_http_session").Scan(&count))
+	assert.Equal(t, uint64(5), count)
+	var blockSize uint64
+	require.NoError(t, conn.QueryRowContext(ctx, "SELECT toUInt64(getSetting('max_block_size'))").Scan(&blockSize))
+	assert.Equal(t, uint64(1234), blockSize)
+
+	// every connection has its own session
+	other, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer other.Close()
+	err = other.QueryRowContext(ctx, "SELECT count() FROM std_test_http_session").Scan(&count)
+	assert.Error(t, err)
+}
@@ -0,0 +1,93 @@
+package std
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	clickhouse_tests "github.com/ClickHouse/clickhouse-go/v2/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStdPrepareSelect(t *testing.T) {
+	dsns := map[string]clickhouse.Protocol{"Native": clickhouse.Native, "Http": clickhouse.HTTP}
+	useSSL, err := strconv.ParseBool(clickhouse_tests.GetEnv("CLICKHOUSE_USE_SSL", "false"))
+	require.NoError(t, err)
+	for name, protocol := range dsns {
+		t.Run(fmt.Sprintf("%s Protocol", name), func(t *testing.T) {
+			conn, err := GetStdDSNConnection(protocol, useSSL, nil)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			const ddl = `CREATE TABLE std_test_prepare (id UInt64, name String) Engine MergeTree() ORDER BY id`
+			conn.Exec("DROP TABLE IF EXISTS std_test_prepare")
+			defer func() {
+				conn.Exec("DROP TABLE IF EXISTS std_test_prepare")
+			}()
+			_, err = conn.Exec(ddl)
+			require.NoError(t, err)
+
+			scope, err := conn.Begin()
+			require.NoError(t, err)
+			batch, err := scope.Prepare("INSERT INTO std_test_prepare")
+			require.NoError(t, err)
+			for i := 0; i < 10; i++ {
+				_, err := batch.Exec(uint64(i), fmt.Sprintf("name %d", i))
+				require.NoError(t, err)
+			}
+			require.NoError(t, scope.Commit())
+
+			stmt, err := conn.Prepare("SELECT name FROM std_test_prepare WHERE id = ?")
+			require.NoError(t, err)
+			defer stmt.Close()
+			for _, id := range []uint64{1, 5, 9} {
+				var name string
+				require.NoError(t, stmt.QueryRow(id).Scan(&name))
+				assert.Equal(t, fmt.Sprintf("name %d", id), name)
+			}
+
+			stmt, err = conn.Prepare("SELECT count() FROM std_test_prepare WHERE id < {max:UInt64}")
+			require.NoError(t, err)
+			defer stmt.Close()
+			var count uint64
+			require.NoError(t, stmt.QueryRow(clickhouse.Named("max", uint64(3))).Scan(&count))
+			assert.Equal(t, uint64(3), count)
+
+			insertSelect, err := conn.Prepare("INSERT INTO std_test_prepare SELECT id + 100, name FROM std_test_prepare WHERE id < ?")
+			require.NoError(t, err)
+			defer insertSelect.Close()
+			_, err = insertSelect.Exec(2)
+			require.NoError(t, err)
+			require.NoError(t, conn.QueryRow("SELECT count() FROM std_test_prepare WHERE id >= 100").Scan(&count))
+			assert.Equal(t, uint64(2), count)
+		})
+	}
+}
+
+func TestStdExecResult(t *testing.T) {
+	dsns := map[string]clickhouse.Protocol{"Native": clickhouse.Native, "Http": clickhouse.HTTP}
+	useSSL, err := strconv.ParseBool(clickhouse_tests.GetEnv("CLICKHOUSE_USE_SSL", "false"))
+	require.NoError(t, err)
+	for name, protocol := range dsns {
+		t.Run(fmt.Sprintf("%s Protocol", name), func(t *testing.T) {
+			conn, err := GetStdDSNConnection(protocol, useSSL, nil)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			conn.Exec("DROP TABLE IF EXISTS std_test_exec_result")
+			defer func() {
+				conn.Exec("DROP TABLE IF EXISTS std_test_exec_result")
+			}()
+			_, err = conn.Exec("CREATE TABLE std_test_exec_result (n UInt64) Engine MergeTree() ORDER BY n")
+			require.NoError(t, err)
+
+			result, err := conn.Exec("INSERT INTO std_test_exec_result SELECT number FROM numbers(42)")
+			require.NoError(t, err)
+			affected, err := result.RowsAffected()
+			require.NoError(t, err)
+			assert.Equal(t, int64(42), affected)
+		})
+	}
+}
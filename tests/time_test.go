@@ -240,3 +240,62 @@ func TestTimeMultipleRows(t *testing.T) {
 		assert.Equal(t, len(expectedTimes), i)
 	})
 }
+
+func TestTimeNegativeAndTimeOfDay(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn := setupTimeTest(t, protocol)
+
+		ctx := clickhouse.Context(context.Background(), clickhouse.WithSettings(clickhouse.Settings{
+			"enable_time_time64_type": 1,
+		}))
+
+		tableName := fmt.Sprintf("test_time_of_day_%d", time.Now().UnixNano())
+		require.NoError(t, conn.Exec(ctx, fmt.Sprintf(`
+			CREATE TABLE %s (
+				id UInt32,
+				elapsed Time,
+				opens Time64(3)
+			) ENGINE = MergeTree() ORDER BY id`, tableName)))
+		defer conn.Exec(ctx, fmt.Sprintf("DROP TABLE IF EXISTS %s", tableName))
+
+		maxTime := 999*time.Hour + 59*time.Minute + 59*time.Second
+		opens := clickhouse.TimeOfDay{Hour: 9, Minute: 30, Nanosecond: 500_000_000}
+
+		batch, err := conn.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s (id, elapsed, opens) VALUES (?, ?, ?)", tableName))
+		require.NoError(t, err)
+		require.NoError(t, batch.Append(uint32(1), -maxTime, opens))
+		require.NoError(t, batch.Append(uint32(2), "123:45:06", "17:00:00.25"))
+		require.NoError(t, batch.Send())
+
+		batch, err = conn.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s (id, elapsed, opens) VALUES (?, ?, ?)", tableName))
+		require.NoError(t, err)
+		require.Error(t, batch.Append(uint32(3), 1000*time.Hour, opens), "values outside the server range are rejected")
+		require.NoError(t, batch.Abort())
+
+		rows, err := conn.Query(ctx, fmt.Sprintf("SELECT elapsed, opens, toString(elapsed) FROM %s ORDER BY id", tableName))
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var (
+			elapsed []time.Duration
+			opening []clickhouse.TimeOfDay
+			printed []string
+		)
+		for rows.Next() {
+			var (
+				e time.Duration
+				o clickhouse.TimeOfDay
+				s string
+			)
+			require.NoError(t, rows.Scan(&e, &o, &s))
+			elapsed = append(elapsed, e)
+			opening = append(opening, o)
+			printed = append(printed, s)
+		}
+		require.NoError(t, rows.Err())
+
+		assert.Equal(t, []time.Duration{-maxTime, 123*time.Hour + 45*time.Minute + 6*time.Second}, elapsed)
+		assert.Equal(t, []clickhouse.TimeOfDay{opens, {Hour: 17, Nanosecond: 250_000_000}}, opening)
+		assert.Equal(t, []string{"-999:59:59", "123:45:06"}, printed)
+	})
+}
@@ -0,0 +1,38 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZeroCopyStrings(t *testing.T) {
+	TestProtocols(t, func(t *testing.T, protocol clickhouse.Protocol) {
+		conn, err := GetNativeConnection(t, protocol, nil, nil, &clickhouse.Compression{
+			Method: clickhouse.CompressionLZ4,
+		})
+		require.NoError(t, err)
+		ctx := clickhouse.Context(context.Background(), clickhouse.WithZeroCopyStrings())
+
+		rows, err := conn.Query(ctx, "SELECT toString(number), toFixedString(toString(number % 10), 1) FROM system.numbers LIMIT 100000")
+		require.NoError(t, err)
+		var i int
+		for rows.Next() {
+			var (
+				str   []byte
+				fixed string
+			)
+			require.NoError(t, rows.Scan(&str, &fixed))
+			require.Equal(t, fmt.Sprint(i), string(str))
+			require.Equal(t, fmt.Sprint(i%10), fixed)
+			i++
+		}
+		require.NoError(t, rows.Err())
+		require.NoError(t, rows.Close())
+		assert.Equal(t, 100000, i)
+	})
+}